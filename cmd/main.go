@@ -21,14 +21,38 @@ func main() {
 		os.Exit(0)
 	}
 
-	cfg, _ := config.LoadConfig()
+	// A config.yaml that does not parse leaves no configuration to run with
+	cfg, err := config.LoadConfig()
+	if err == nil {
+		err = tui.ApplyKeyBindings(cfg.Keys)
+	}
+	if err == nil {
+		err = tui.ApplyTheme(cfg.Theme)
+	}
+	var columns k8s.ExtraColumns
+	if err == nil {
+		columns, err = k8s.CompileExtraColumns(columnSpecs(cfg.Columns))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config.yaml : %v\n", err)
+		os.Exit(1)
+	}
+
+	newClient := func() (*k8s.Client, error) {
+		client, err := k8s.NewClient()
+		if err != nil {
+			return nil, err
+		}
+		client.SetExtraColumns(columns)
+		return client, nil
+	}
 
 	// ClientFactory wraps k8s.NewClient to return the domain interface.
 	factory := func() (domain.KubeGateway, error) {
-		return k8s.NewClient()
+		return newClient()
 	}
 
-	client, err := k8s.NewClient()
+	client, err := newClient()
 	if err != nil {
 		// Client creation failed -- launch TUI in error mode
 		m := tui.NewModelWithError(err, factory)
@@ -48,3 +72,19 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// columnSpecs converts the columns section of config.yaml for the adapter.
+func columnSpecs(cfg config.ColumnsConfig) domain.ColumnSpecs {
+	convert := func(cols []config.ColumnConfig) []domain.ColumnSpec {
+		specs := make([]domain.ColumnSpec, len(cols))
+		for i, c := range cols {
+			specs[i] = domain.ColumnSpec(c)
+		}
+		return specs
+	}
+	return domain.ColumnSpecs{
+		Pods:        convert(cfg.Pods),
+		Deployments: convert(cfg.Deployments),
+		Events:      convert(cfg.Events),
+	}
+}
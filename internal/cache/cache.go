@@ -68,6 +68,79 @@ func (c *CachedGateway) Reconnect() error {
 	return err
 }
 
+// SetAllNamespaces switches the lists cached to or from every namespace.
+func (c *CachedGateway) SetAllNamespaces(all bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.delegate.SetAllNamespaces(all)
+	c.invalidateAll()
+}
+
+func (c *CachedGateway) AllNamespaces() bool { return c.delegate.AllNamespaces() }
+
+// InNamespace returns the delegate bound to ns. Its reads are not cached;
+// its actions drop the cached list they change once they succeeded.
+func (c *CachedGateway) InNamespace(ns string) domain.KubeGateway {
+	return &scopedGateway{KubeGateway: c.delegate.InNamespace(ns), parent: c}
+}
+
+// scopedGateway is the delegate bound to another namespace. The lists of
+// the parent may hold its objects, e.g. in all-namespaces mode.
+type scopedGateway struct {
+	domain.KubeGateway
+	parent *CachedGateway
+}
+
+func (s *scopedGateway) InNamespace(ns string) domain.KubeGateway {
+	return s.parent.InNamespace(ns)
+}
+
+func (s *scopedGateway) DeletePod(ctx context.Context, podName string) error {
+	err := s.KubeGateway.DeletePod(ctx, podName)
+	if err == nil {
+		s.parent.dropPods()
+	}
+	return err
+}
+
+func (s *scopedGateway) CreateDebugContainer(ctx context.Context, podName, targetContainer, image string) (string, error) {
+	name, err := s.KubeGateway.CreateDebugContainer(ctx, podName, targetContainer, image)
+	if err == nil {
+		s.parent.dropPods()
+	}
+	return name, err
+}
+
+func (s *scopedGateway) ScaleDeployment(ctx context.Context, name string, replicas int32) error {
+	err := s.KubeGateway.ScaleDeployment(ctx, name, replicas)
+	if err == nil {
+		s.parent.dropDeployments()
+	}
+	return err
+}
+
+func (s *scopedGateway) RestartDeployment(ctx context.Context, name string) error {
+	err := s.KubeGateway.RestartDeployment(ctx, name)
+	if err == nil {
+		s.parent.dropDeployments()
+	}
+	return err
+}
+
+func (c *CachedGateway) ListContexts() ([]domain.ContextInfo, error) {
+	return c.delegate.ListContexts()
+}
+
+// SwitchContext returns a gateway on the other cluster with a cache of its
+// own, so that lists still loading from the previous one do not fill it.
+func (c *CachedGateway) SwitchContext(name string) (domain.KubeGateway, error) {
+	gw, err := c.delegate.SwitchContext(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewCachedGateway(gw, c.cfg), nil
+}
+
 // --- Cached List operations ---
 
 func (c *CachedGateway) ListPods(ctx context.Context) ([]domain.PodInfo, error) {
@@ -164,22 +237,46 @@ func (c *CachedGateway) ListEvents(ctx context.Context) ([]domain.EventInfo, err
 
 // --- Mutations (pass-through + invalidate) ---
 
+func (c *CachedGateway) dropPods() {
+	c.mu.Lock()
+	c.pods = nil
+	c.mu.Unlock()
+}
+
+func (c *CachedGateway) dropDeployments() {
+	c.mu.Lock()
+	c.deployments = nil
+	c.mu.Unlock()
+}
+
 func (c *CachedGateway) DeletePod(ctx context.Context, podName string) error {
 	err := c.delegate.DeletePod(ctx, podName)
 	if err == nil {
-		c.mu.Lock()
-		c.pods = nil
-		c.mu.Unlock()
+		c.dropPods()
 	}
 	return err
 }
 
+func (c *CachedGateway) CreateDebugContainer(ctx context.Context, podName, targetContainer, image string) (string, error) {
+	name, err := c.delegate.CreateDebugContainer(ctx, podName, targetContainer, image)
+	if err == nil {
+		c.dropPods()
+	}
+	return name, err
+}
+
 func (c *CachedGateway) ScaleDeployment(ctx context.Context, name string, replicas int32) error {
 	err := c.delegate.ScaleDeployment(ctx, name, replicas)
 	if err == nil {
-		c.mu.Lock()
-		c.deployments = nil
-		c.mu.Unlock()
+		c.dropDeployments()
+	}
+	return err
+}
+
+func (c *CachedGateway) RestartDeployment(ctx context.Context, name string) error {
+	err := c.delegate.RestartDeployment(ctx, name)
+	if err == nil {
+		c.dropDeployments()
 	}
 	return err
 }
@@ -194,12 +291,24 @@ func (c *CachedGateway) WatchDeployments(ctx context.Context) (<-chan domain.Wat
 	return c.delegate.WatchDeployments(ctx)
 }
 
+func (c *CachedGateway) ListObjectEvents(ctx context.Context, ref domain.ObjectRef) ([]domain.EventInfo, error) {
+	return c.delegate.ListObjectEvents(ctx, ref)
+}
+
+func (c *CachedGateway) ListNodes(ctx context.Context) ([]domain.NodeInfo, error) {
+	return c.delegate.ListNodes(ctx)
+}
+
 func (c *CachedGateway) WatchEvents(ctx context.Context) (<-chan domain.WatchEvent, error) {
 	return c.delegate.WatchEvents(ctx)
 }
 
-func (c *CachedGateway) GetPodLogs(ctx context.Context, podName, containerName string, tailLines int64, previous bool) (string, error) {
-	return c.delegate.GetPodLogs(ctx, podName, containerName, tailLines, previous)
+func (c *CachedGateway) GetPodLogs(ctx context.Context, podName, containerName string, opts domain.LogOptions) (string, error) {
+	return c.delegate.GetPodLogs(ctx, podName, containerName, opts)
+}
+
+func (c *CachedGateway) StreamPodLogs(ctx context.Context, podName, containerName string, opts domain.LogOptions) (<-chan domain.LogLine, error) {
+	return c.delegate.StreamPodLogs(ctx, podName, containerName, opts)
 }
 
 func (c *CachedGateway) GetPodYAML(ctx context.Context, podName string) (string, error) {
@@ -213,3 +322,19 @@ func (c *CachedGateway) GetDeploymentYAML(ctx context.Context, name string) (str
 func (c *CachedGateway) BuildExecCmd(namespace, podName, containerName, shell string) (*exec.Cmd, error) {
 	return c.delegate.BuildExecCmd(namespace, podName, containerName, shell)
 }
+
+func (c *CachedGateway) RunCommand(ctx context.Context, namespace, podName, containerName string, command []string) (domain.CommandResult, error) {
+	return c.delegate.RunCommand(ctx, namespace, podName, containerName, command)
+}
+
+func (c *CachedGateway) CopyFromPod(ctx context.Context, namespace, podName, containerName, remotePath, localPath string, progress func(int64)) error {
+	return c.delegate.CopyFromPod(ctx, namespace, podName, containerName, remotePath, localPath, progress)
+}
+
+func (c *CachedGateway) CopyToPod(ctx context.Context, namespace, podName, containerName, localPath, remotePath string, progress func(int64)) error {
+	return c.delegate.CopyToPod(ctx, namespace, podName, containerName, localPath, remotePath, progress)
+}
+
+func (c *CachedGateway) NewExecSession(namespace, podName, containerName string, command []string) (domain.ExecSession, error) {
+	return c.delegate.NewExecSession(namespace, podName, containerName, command)
+}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -79,6 +80,58 @@ func TestCachedGateway_ScaleDeployment_InvalidatesCache(t *testing.T) {
 	}
 }
 
+func TestCachedGateway_RestartDeployment_InvalidatesCache(t *testing.T) {
+	c, mock := newTestCache()
+	ctx := context.Background()
+
+	_, _ = c.ListDeployments(ctx)
+	_ = c.RestartDeployment(ctx, "api")
+	_, _ = c.ListDeployments(ctx)
+
+	if mock.ListDeploymentsCalls != 2 || len(mock.RestartedDeps) != 1 {
+		t.Errorf("ListDeploymentsCalls = %d, restarted %v; want 2 and the restart delegated", mock.ListDeploymentsCalls, mock.RestartedDeps)
+	}
+}
+
+func TestCachedGateway_InNamespace_InvalidatesAfterAction(t *testing.T) {
+	c, mock := newTestCache()
+	ctx := context.Background()
+
+	_, _ = c.ListPods(ctx)
+	_, _ = c.ListDeployments(ctx)
+
+	// Reads through the scoped gateway leave the cache alone
+	scoped := c.InNamespace("team-b")
+	_, _ = scoped.GetPodLogs(ctx, "web-1", "", domain.LogOptions{})
+	_, _ = c.InNamespace("team-c").ListObjectEvents(ctx, domain.ObjectRef{Kind: "Pod", Name: "web-1"})
+	_, _ = c.ListPods(ctx)
+	if mock.ListPodsCalls != 1 {
+		t.Errorf("ListPodsCalls = %d, want 1: reads should not invalidate", mock.ListPodsCalls)
+	}
+
+	// A failed action keeps it too
+	mock.DeletePodErr = errors.New("forbidden")
+	_ = scoped.DeletePod(ctx, "web-1")
+	_, _ = c.ListPods(ctx)
+	if mock.ListPodsCalls != 1 {
+		t.Errorf("ListPodsCalls = %d, want 1 after a failed delete", mock.ListPodsCalls)
+	}
+
+	// A successful one drops the kind it changed only
+	mock.DeletePodErr = nil
+	_ = scoped.DeletePod(ctx, "web-1")
+	_, _ = c.ListPods(ctx)
+	_, _ = c.ListDeployments(ctx)
+	if mock.ListPodsCalls != 2 || mock.ListDeploymentsCalls != 1 {
+		t.Errorf("ListPodsCalls = %d, ListDeploymentsCalls = %d, want 2 and 1", mock.ListPodsCalls, mock.ListDeploymentsCalls)
+	}
+	_ = scoped.RestartDeployment(ctx, "api")
+	_, _ = c.ListDeployments(ctx)
+	if mock.ListDeploymentsCalls != 2 {
+		t.Errorf("ListDeploymentsCalls = %d, want 2 after a restart", mock.ListDeploymentsCalls)
+	}
+}
+
 func TestCachedGateway_SetNamespace_InvalidatesAll(t *testing.T) {
 	c, mock := newTestCache()
 	ctx := context.Background()
@@ -127,6 +180,30 @@ func TestCachedGateway_Reconnect_InvalidatesAll(t *testing.T) {
 	}
 }
 
+func TestCachedGateway_SwitchContext_OwnCache(t *testing.T) {
+	c, mock := newTestCache()
+	ctx := context.Background()
+
+	_, _ = c.ListPods(ctx)
+	_, _ = c.ListNamespaces(ctx)
+	gw, err := c.SwitchContext("prod-east")
+	if err != nil {
+		t.Fatalf("SwitchContext() error = %v", err)
+	}
+	if _, ok := gw.(*CachedGateway); !ok || gw == domain.KubeGateway(c) {
+		t.Fatalf("SwitchContext() = %T, want a new cached gateway", gw)
+	}
+	_, _ = gw.ListPods(ctx)
+	_, _ = gw.ListNamespaces(ctx)
+
+	if mock.ListPodsCalls != 2 || mock.ListNamespacesCalls != 2 {
+		t.Errorf("ListPodsCalls = %d, ListNamespacesCalls = %d, want 2 each", mock.ListPodsCalls, mock.ListNamespacesCalls)
+	}
+	if mock.SwitchedContext != "prod-east" {
+		t.Errorf("SwitchedContext = %q, want prod-east", mock.SwitchedContext)
+	}
+}
+
 func TestCachedGateway_CachesNamespaces(t *testing.T) {
 	c, mock := newTestCache()
 	ctx := context.Background()
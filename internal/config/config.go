@@ -14,10 +14,21 @@ var DefaultProdPatterns = []string{"prod", "production", "prd", "live"}
 
 // AppConfig holds all configuration for okd-tui.
 type AppConfig struct {
-	ProdPatterns       []string    `yaml:"prod_patterns"`
-	ReadonlyNamespaces []string    `yaml:"readonly_namespaces"`
-	Cache              CacheConfig `yaml:"cache"`
-	Exec               ExecConfig  `yaml:"exec"`
+	ProdPatterns       []string      `yaml:"prod_patterns"`
+	ReadonlyNamespaces []string      `yaml:"readonly_namespaces"`
+	Cache              CacheConfig   `yaml:"cache"`
+	Exec               ExecConfig    `yaml:"exec"`
+	Logs               LogsConfig    `yaml:"logs"`
+	Export             ExportConfig  `yaml:"export"`
+	Alerts             AlertsConfig  `yaml:"alerts"`
+	Palette            PaletteConfig `yaml:"palette"`
+	Columns            ColumnsConfig `yaml:"columns"`
+	// Theme is auto, dark, light, high-contrast or ansi16. Terminals without
+	// 256 colors always get ansi16.
+	Theme string `yaml:"theme"`
+	// Keys remaps key bindings by action name ("shell": ["S"]). The keys
+	// replace the defaults of the action.
+	Keys map[string][]string `yaml:"keys"`
 }
 
 // CacheConfig holds TTL settings for cached resources.
@@ -28,9 +39,102 @@ type CacheConfig struct {
 	EventsTTL      time.Duration `yaml:"events"`
 }
 
+// Exec modes select how the TUI opens a shell in a pod.
+const (
+	ExecModeAuto     = "auto"     // oc/kubectl if found, built-in otherwise
+	ExecModeExternal = "external" // oc/kubectl only
+	ExecModeBuiltin  = "builtin"  // client-go remotecommand only
+)
+
+// DefaultDebugImage is the image used for ephemeral debug containers.
+const DefaultDebugImage = "busybox:1.36"
+
 // ExecConfig holds exec/shell settings.
 type ExecConfig struct {
-	Shell string `yaml:"shell"`
+	Shell      string `yaml:"shell"`
+	Mode       string `yaml:"mode"`
+	DebugImage string `yaml:"debug_image"`
+}
+
+// Log fetch defaults.
+const (
+	DefaultLogTailLines  = 200
+	DefaultLogLimitBytes = 10 * 1024 * 1024
+)
+
+// LogsConfig holds log view settings.
+type LogsConfig struct {
+	// TailLines is the number of lines of the "last N lines" range.
+	TailLines int64 `yaml:"tail_lines"`
+	// LimitBytes caps every log snapshot, so that "since pod start" on a
+	// chatty container stays bounded. Followed streams are not capped.
+	LimitBytes int64 `yaml:"limit_bytes"`
+	// JSONFields are the keys appended to the message of JSON log lines in
+	// compact mode. Empty means all of them.
+	JSONFields []string `yaml:"json_fields"`
+}
+
+// DefaultExportDir is where logs and YAML are saved; ~ is the home directory.
+const DefaultExportDir = "~/okd-tui-exports"
+
+// ExportConfig holds settings for saving logs and YAML to files.
+type ExportConfig struct {
+	Dir string `yaml:"dir"`
+}
+
+// DefaultPaletteHistoryFile keeps the ":" commands between sessions; ~ is
+// the home directory.
+const DefaultPaletteHistoryFile = "~/.config/okd-tui/history"
+
+// PaletteConfig holds settings for the ":" command prompt.
+type PaletteConfig struct {
+	HistoryFile string `yaml:"history_file"`
+}
+
+// ColumnConfig is an extra column of a list view. Its value is read from
+// the raw object with a JSONPath expression ({.spec.nodeName}), or from a
+// label or an annotation.
+type ColumnConfig struct {
+	Name       string `yaml:"name"`
+	JSONPath   string `yaml:"jsonpath"`
+	Label      string `yaml:"label"`
+	Annotation string `yaml:"annotation"`
+}
+
+// ColumnsConfig lists the extra columns of each list view. Names are unique
+// within a view.
+type ColumnsConfig struct {
+	Pods        []ColumnConfig `yaml:"pods"`
+	Deployments []ColumnConfig `yaml:"deployments"`
+	Events      []ColumnConfig `yaml:"events"`
+}
+
+// AlertsConfig selects the Warning events notified while browsing other
+// views. Reasons are glob patterns ("Failed*").
+type AlertsConfig struct {
+	Disabled bool `yaml:"disabled"`
+	// Reasons, when set, limits notifications to these reasons.
+	Reasons []string `yaml:"reasons"`
+	// IgnoreReasons are never notified.
+	IgnoreReasons []string `yaml:"ignore_reasons"`
+}
+
+// Notifies reports whether a Warning event with this reason raises a
+// notification.
+func (a AlertsConfig) Notifies(reason string) bool {
+	if a.Disabled || matchesAny(reason, a.IgnoreReasons) {
+		return false
+	}
+	return len(a.Reasons) == 0 || matchesAny(reason, a.Reasons)
+}
+
+func matchesAny(s string, patterns []string) bool {
+	for _, p := range patterns {
+		if matched, err := filepath.Match(p, s); err == nil && matched {
+			return true
+		}
+	}
+	return false
 }
 
 // DefaultConfig returns a config with sensible defaults.
@@ -45,7 +149,19 @@ func DefaultConfig() *AppConfig {
 			EventsTTL:      10 * time.Second,
 		},
 		Exec: ExecConfig{
-			Shell: "/bin/sh",
+			Shell:      "/bin/sh",
+			Mode:       ExecModeAuto,
+			DebugImage: DefaultDebugImage,
+		},
+		Logs: LogsConfig{
+			TailLines:  DefaultLogTailLines,
+			LimitBytes: DefaultLogLimitBytes,
+		},
+		Export: ExportConfig{
+			Dir: DefaultExportDir,
+		},
+		Palette: PaletteConfig{
+			HistoryFile: DefaultPaletteHistoryFile,
 		},
 	}
 }
@@ -95,6 +211,26 @@ func LoadConfigFrom(path string) (*AppConfig, error) {
 	if cfg.Exec.Shell == "" {
 		cfg.Exec.Shell = "/bin/sh"
 	}
+	if cfg.Exec.DebugImage == "" {
+		cfg.Exec.DebugImage = DefaultDebugImage
+	}
+	if cfg.Logs.TailLines <= 0 {
+		cfg.Logs.TailLines = DefaultLogTailLines
+	}
+	if cfg.Logs.LimitBytes <= 0 {
+		cfg.Logs.LimitBytes = DefaultLogLimitBytes
+	}
+	if cfg.Export.Dir == "" {
+		cfg.Export.Dir = DefaultExportDir
+	}
+	if cfg.Palette.HistoryFile == "" {
+		cfg.Palette.HistoryFile = DefaultPaletteHistoryFile
+	}
+	switch cfg.Exec.Mode {
+	case ExecModeExternal, ExecModeBuiltin:
+	default:
+		cfg.Exec.Mode = ExecModeAuto
+	}
 
 	return cfg, nil
 }
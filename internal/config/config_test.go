@@ -105,6 +105,68 @@ func TestLoadConfig_Defaults(t *testing.T) {
 	if cfg.Exec.Shell != "/bin/sh" {
 		t.Errorf("Exec.Shell = %q, want /bin/sh", cfg.Exec.Shell)
 	}
+	if cfg.Exec.Mode != ExecModeAuto {
+		t.Errorf("Exec.Mode = %q, want %q", cfg.Exec.Mode, ExecModeAuto)
+	}
+	if cfg.Exec.DebugImage != DefaultDebugImage {
+		t.Errorf("Exec.DebugImage = %q, want %q", cfg.Exec.DebugImage, DefaultDebugImage)
+	}
+
+	// Logs defaults
+	if cfg.Logs.TailLines != DefaultLogTailLines || cfg.Logs.LimitBytes != DefaultLogLimitBytes {
+		t.Errorf("Logs = %+v, want default tail and limit", cfg.Logs)
+	}
+	if cfg.Export.Dir != DefaultExportDir {
+		t.Errorf("Export.Dir = %q, want %q", cfg.Export.Dir, DefaultExportDir)
+	}
+	if cfg.Palette.HistoryFile != DefaultPaletteHistoryFile {
+		t.Errorf("Palette.HistoryFile = %q, want %q", cfg.Palette.HistoryFile, DefaultPaletteHistoryFile)
+	}
+}
+
+func TestLoadConfig_Logs(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := "logs:\n  tail_lines: 1000\n  json_fields: [trace_id]\n"
+	if err := os.WriteFile(cfgPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfigFrom(cfgPath)
+	if err != nil {
+		t.Fatalf("LoadConfigFrom returned error: %v", err)
+	}
+	if cfg.Logs.TailLines != 1000 || cfg.Logs.LimitBytes != DefaultLogLimitBytes {
+		t.Errorf("Logs = %+v, want tail 1000 and default limit", cfg.Logs)
+	}
+	if len(cfg.Logs.JSONFields) != 1 || cfg.Logs.JSONFields[0] != "trace_id" {
+		t.Errorf("Logs.JSONFields = %v", cfg.Logs.JSONFields)
+	}
+}
+
+func TestLoadConfig_ExecMode(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"builtin", ExecModeBuiltin},
+		{"external", ExecModeExternal},
+		{"auto", ExecModeAuto},
+		{"bogus", ExecModeAuto},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			cfgPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(cfgPath, []byte("exec:\n  mode: "+tt.value+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := LoadConfigFrom(cfgPath)
+			if err != nil {
+				t.Fatalf("LoadConfigFrom returned error: %v", err)
+			}
+			if cfg.Exec.Mode != tt.want {
+				t.Errorf("Exec.Mode = %q, want %q", cfg.Exec.Mode, tt.want)
+			}
+		})
+	}
 }
 
 func TestLoadConfig_CustomFile(t *testing.T) {
@@ -123,6 +185,8 @@ cache:
   events: 15s
 exec:
   shell: /bin/bash
+keys:
+  shell: [S]
 `
 	if err := os.WriteFile(cfgPath, []byte(content), 0644); err != nil {
 		t.Fatal(err)
@@ -148,6 +212,9 @@ exec:
 	if cfg.Exec.Shell != "/bin/bash" {
 		t.Errorf("Exec.Shell = %q, want /bin/bash", cfg.Exec.Shell)
 	}
+	if ks := cfg.Keys["shell"]; len(ks) != 1 || ks[0] != "S" {
+		t.Errorf("Keys[shell] = %v, want [S]", ks)
+	}
 }
 
 func TestLoadConfig_InvalidYAML(t *testing.T) {
@@ -208,3 +275,26 @@ func TestSplitSegments(t *testing.T) {
 		})
 	}
 }
+
+func TestAlertsConfig_Notifies(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    AlertsConfig
+		reason string
+		want   bool
+	}{
+		{"default notifies all", AlertsConfig{}, "BackOff", true},
+		{"disabled", AlertsConfig{Disabled: true}, "BackOff", false},
+		{"reason listed", AlertsConfig{Reasons: []string{"BackOff", "Failed*"}}, "FailedMount", true},
+		{"reason not listed", AlertsConfig{Reasons: []string{"BackOff"}}, "Unhealthy", false},
+		{"ignored", AlertsConfig{IgnoreReasons: []string{"Unhealthy"}}, "Unhealthy", false},
+		{"ignore wins", AlertsConfig{Reasons: []string{"Failed*"}, IgnoreReasons: []string{"FailedScheduling"}}, "FailedScheduling", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.Notifies(tt.reason); got != tt.want {
+				t.Errorf("Notifies(%q) = %v, want %v", tt.reason, got, tt.want)
+			}
+		})
+	}
+}
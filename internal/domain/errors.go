@@ -1,5 +1,14 @@
 package domain
 
+import "errors"
+
+// ErrNoExecTool is returned by BuildExecCmd when neither oc nor kubectl is in the PATH.
+// The TUI falls back to the built-in exec when it sees this error.
+var ErrNoExecTool = errors.New("ni 'oc' ni 'kubectl' trouvé dans le PATH")
+
+// ErrNoTar is returned by the FileTransfer methods when the container image has no tar binary.
+var ErrNoTar = errors.New("'tar' introuvable dans le conteneur — copie impossible")
+
 // ErrType classifies errors for the TUI to display appropriate messages.
 type ErrType int
 
@@ -0,0 +1,23 @@
+package domain
+
+import "testing"
+
+func TestSplitLogTimestamp(t *testing.T) {
+	ts, text := SplitLogTimestamp("2024-05-01T10:00:00.123456789Z GET /health 200")
+	if ts.IsZero() || ts.Nanosecond() != 123456789 {
+		t.Errorf("timestamp = %v, want parsed nanoseconds", ts)
+	}
+	if text != "GET /health 200" {
+		t.Errorf("text = %q", text)
+	}
+
+	ts, text = SplitLogTimestamp("no timestamp here")
+	if !ts.IsZero() || text != "no timestamp here" {
+		t.Errorf("got (%v, %q), want line unchanged", ts, text)
+	}
+
+	ts, text = SplitLogTimestamp("2024-05-01T10:00:00Z")
+	if ts.IsZero() || text != "" {
+		t.Errorf("got (%v, %q), want timestamp with empty text", ts, text)
+	}
+}
@@ -2,19 +2,29 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"os/exec"
+	"sync"
 )
 
 // MockGateway implements KubeGateway for testing.
 type MockGateway struct {
+	// mu guards the pod and deployment actions, which bulk actions run
+	// concurrently.
+	mu sync.Mutex
+
 	ContextVal   string
 	ServerURLVal string
 	NamespaceVal string
+	AllNamespacesVal bool
 
 	Pods        []PodInfo
 	Deployments []DeploymentInfo
 	Namespaces  []NamespaceInfo
+	Nodes       []NodeInfo
 	Events      []EventInfo
+	Contexts    []ContextInfo
+	ObjectEvents []EventInfo // returned by ListObjectEvents
 	LogContent  string
 
 	// Watch channels (inject from tests)
@@ -26,8 +36,21 @@ type MockGateway struct {
 	PodYAML        string
 	DeploymentYAML string
 
+	// Followed logs (inject from tests); LogStreams is keyed by "pod/container"
+	// and takes precedence over LogStreamCh when set.
+	LogStreamCh chan LogLine
+	LogStreams  map[string]chan LogLine
+
+	// Debug
+	DebugContainerName string
+
 	// Exec
-	ExecCmd *exec.Cmd
+	ExecCmd       *exec.Cmd
+	ExecSession   ExecSession
+	CommandResult CommandResult
+
+	// File copy: bytes reported to the progress callback
+	CopyBytes int64
 
 	// Error injection
 	GetPodYAMLErr        error
@@ -35,28 +58,56 @@ type MockGateway struct {
 	ListPodsErr          error
 	ListDeploymentsErr  error
 	ListNamespacesErr   error
+	ListNodesErr        error
 	GetPodLogsErr       error
+	StreamPodLogsErr    error
 	DeletePodErr        error
 	ScaleErr            error
+	RestartErr          error
+	ActionErrs          map[string]error // by resource name: delete, scale and restart
 	ReconnectErr        error
+	SwitchContextErr    error
 	WatchPodsErr        error
 	WatchDeploymentsErr error
 	ListEventsErr       error
+	ListObjectEventsErr error
 	WatchEventsErr      error
 	BuildExecErr        error
+	NewExecSessionErr   error
+	RunCommandErr       error
+	CreateDebugErr      error
+	CopyErr             error
 
 	// Call tracking
 	DeletedPod           string
+	DeletedPods          []string
 	ScaledDep            string
 	ScaledTo             int32
+	ScaledDeps           map[string]int32
+	RestartedDeps        []string
 	ReconnectCalls       int
+	SwitchedContext      string
+	RoutedNamespaces     []string // InNamespace calls
 	LoggedContainer      string
+	LogOpts              LogOptions
 	ListPodsCalls        int
 	ListDeploymentsCalls int
 	ListNamespacesCalls  int
 	ListEventsCalls      int
+	EventsRef            ObjectRef
 	ExecPod              string
 	ExecContainer        string
+	ExecCommand          []string
+	RanCommand           []string
+	RunCommandCalls      int
+	DebugPod             string
+	DebugTarget          string
+	DebugImage           string
+	CopyDirection        string // "from" or "to"
+	CopyPod              string
+	CopyContainer        string
+	CopySrc              string
+	CopyDst              string
 }
 
 // Compile-time check.
@@ -67,11 +118,36 @@ func (m *MockGateway) GetServerURL() string  { return m.ServerURLVal }
 func (m *MockGateway) GetNamespace() string  { return m.NamespaceVal }
 func (m *MockGateway) SetNamespace(ns string) { m.NamespaceVal = ns }
 
+func (m *MockGateway) SetAllNamespaces(all bool) { m.AllNamespacesVal = all }
+func (m *MockGateway) AllNamespaces() bool       { return m.AllNamespacesVal }
+
+// InNamespace records the namespace and returns the mock itself.
+func (m *MockGateway) InNamespace(ns string) KubeGateway {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RoutedNamespaces = append(m.RoutedNamespaces, ns)
+	return m
+}
+
 func (m *MockGateway) Reconnect() error {
 	m.ReconnectCalls++
 	return m.ReconnectErr
 }
 
+func (m *MockGateway) ListContexts() ([]ContextInfo, error) {
+	return m.Contexts, nil
+}
+
+// SwitchContext records the context and returns the mock itself.
+func (m *MockGateway) SwitchContext(name string) (KubeGateway, error) {
+	if m.SwitchContextErr != nil {
+		return nil, m.SwitchContextErr
+	}
+	m.SwitchedContext = name
+	m.ContextVal = name
+	return m, nil
+}
+
 func (m *MockGateway) WatchPods(_ context.Context) (<-chan WatchEvent, error) {
 	if m.WatchPodsErr != nil {
 		return nil, m.WatchPodsErr
@@ -94,19 +170,52 @@ func (m *MockGateway) ListPods(_ context.Context) ([]PodInfo, error) {
 	return m.Pods, nil
 }
 
-func (m *MockGateway) GetPodLogs(_ context.Context, _ string, containerName string, _ int64, _ bool) (string, error) {
+func (m *MockGateway) GetPodLogs(_ context.Context, _ string, containerName string, opts LogOptions) (string, error) {
 	m.LoggedContainer = containerName
+	m.LogOpts = opts
 	if m.GetPodLogsErr != nil {
 		return "", m.GetPodLogsErr
 	}
 	return m.LogContent, nil
 }
 
+func (m *MockGateway) StreamPodLogs(_ context.Context, podName, containerName string, opts LogOptions) (<-chan LogLine, error) {
+	m.LoggedContainer = containerName
+	m.LogOpts = opts
+	if m.StreamPodLogsErr != nil {
+		return nil, m.StreamPodLogsErr
+	}
+	if m.LogStreams != nil {
+		ch, ok := m.LogStreams[podName+"/"+containerName]
+		if !ok {
+			return nil, fmt.Errorf("no log stream for %s/%s", podName, containerName)
+		}
+		return ch, nil
+	}
+	return m.LogStreamCh, nil
+}
+
 func (m *MockGateway) DeletePod(_ context.Context, podName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.ActionErrs[podName]; err != nil {
+		return err
+	}
 	m.DeletedPod = podName
+	m.DeletedPods = append(m.DeletedPods, podName)
 	return m.DeletePodErr
 }
 
+func (m *MockGateway) CreateDebugContainer(_ context.Context, podName, targetContainer, image string) (string, error) {
+	m.DebugPod = podName
+	m.DebugTarget = targetContainer
+	m.DebugImage = image
+	if m.CreateDebugErr != nil {
+		return "", m.CreateDebugErr
+	}
+	return m.DebugContainerName, nil
+}
+
 func (m *MockGateway) ListDeployments(_ context.Context) ([]DeploymentInfo, error) {
 	m.ListDeploymentsCalls++
 	if m.ListDeploymentsErr != nil {
@@ -116,11 +225,30 @@ func (m *MockGateway) ListDeployments(_ context.Context) ([]DeploymentInfo, erro
 }
 
 func (m *MockGateway) ScaleDeployment(_ context.Context, name string, replicas int32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.ActionErrs[name]; err != nil {
+		return err
+	}
 	m.ScaledDep = name
 	m.ScaledTo = replicas
+	if m.ScaledDeps == nil {
+		m.ScaledDeps = make(map[string]int32)
+	}
+	m.ScaledDeps[name] = replicas
 	return m.ScaleErr
 }
 
+func (m *MockGateway) RestartDeployment(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.ActionErrs[name]; err != nil {
+		return err
+	}
+	m.RestartedDeps = append(m.RestartedDeps, name)
+	return m.RestartErr
+}
+
 func (m *MockGateway) ListEvents(_ context.Context) ([]EventInfo, error) {
 	m.ListEventsCalls++
 	if m.ListEventsErr != nil {
@@ -129,6 +257,14 @@ func (m *MockGateway) ListEvents(_ context.Context) ([]EventInfo, error) {
 	return m.Events, nil
 }
 
+func (m *MockGateway) ListObjectEvents(_ context.Context, ref ObjectRef) ([]EventInfo, error) {
+	m.EventsRef = ref
+	if m.ListObjectEventsErr != nil {
+		return nil, m.ListObjectEventsErr
+	}
+	return m.ObjectEvents, nil
+}
+
 func (m *MockGateway) WatchEvents(_ context.Context) (<-chan WatchEvent, error) {
 	if m.WatchEventsErr != nil {
 		return nil, m.WatchEventsErr
@@ -144,6 +280,13 @@ func (m *MockGateway) ListNamespaces(_ context.Context) ([]NamespaceInfo, error)
 	return m.Namespaces, nil
 }
 
+func (m *MockGateway) ListNodes(_ context.Context) ([]NodeInfo, error) {
+	if m.ListNodesErr != nil {
+		return nil, m.ListNodesErr
+	}
+	return m.Nodes, nil
+}
+
 func (m *MockGateway) BuildExecCmd(_, podName, containerName, _ string) (*exec.Cmd, error) {
 	m.ExecPod = podName
 	m.ExecContainer = containerName
@@ -153,6 +296,50 @@ func (m *MockGateway) BuildExecCmd(_, podName, containerName, _ string) (*exec.C
 	return m.ExecCmd, nil
 }
 
+func (m *MockGateway) NewExecSession(_, podName, containerName string, command []string) (ExecSession, error) {
+	m.ExecPod = podName
+	m.ExecContainer = containerName
+	m.ExecCommand = command
+	if m.NewExecSessionErr != nil {
+		return nil, m.NewExecSessionErr
+	}
+	return m.ExecSession, nil
+}
+
+func (m *MockGateway) RunCommand(_ context.Context, _, podName, containerName string, command []string) (CommandResult, error) {
+	m.RunCommandCalls++
+	m.ExecPod = podName
+	m.ExecContainer = containerName
+	m.RanCommand = command
+	if m.RunCommandErr != nil {
+		return CommandResult{}, m.RunCommandErr
+	}
+	return m.CommandResult, nil
+}
+
+func (m *MockGateway) CopyFromPod(_ context.Context, _, podName, containerName, remotePath, localPath string, progress func(int64)) error {
+	return m.recordCopy("from", podName, containerName, remotePath, localPath, progress)
+}
+
+func (m *MockGateway) CopyToPod(_ context.Context, _, podName, containerName, localPath, remotePath string, progress func(int64)) error {
+	return m.recordCopy("to", podName, containerName, localPath, remotePath, progress)
+}
+
+func (m *MockGateway) recordCopy(direction, podName, containerName, src, dst string, progress func(int64)) error {
+	m.CopyDirection = direction
+	m.CopyPod = podName
+	m.CopyContainer = containerName
+	m.CopySrc = src
+	m.CopyDst = dst
+	if m.CopyErr != nil {
+		return m.CopyErr
+	}
+	if progress != nil && m.CopyBytes > 0 {
+		progress(m.CopyBytes)
+	}
+	return nil
+}
+
 func (m *MockGateway) GetPodYAML(_ context.Context, _ string) (string, error) {
 	if m.GetPodYAMLErr != nil {
 		return "", m.GetPodYAMLErr
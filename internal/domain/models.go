@@ -1,37 +1,57 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// Container kinds, in the order they are listed in PodInfo.Containers.
+const (
+	ContainerKindInit      = "init"
+	ContainerKindApp       = "container"
+	ContainerKindEphemeral = "ephemeral"
+)
 
 // ContainerInfo describes one container inside a pod.
 type ContainerInfo struct {
-	Name  string
-	Ready bool
-	State string // "running", "waiting", "terminated"
+	Name   string
+	Image  string
+	Kind   string // ContainerKindInit, ContainerKindApp or ContainerKindEphemeral; empty means app
+	Ready  bool
+	State  string // "running", "waiting", "terminated"
+	Reason string // waiting or terminated reason, e.g. "CrashLoopBackOff", "Completed", "Error"
 }
 
 // PodInfo represents a Kubernetes pod for display in the TUI.
 type PodInfo struct {
 	Name       string
+	UID        string
 	Namespace  string
+	Phase      string // Pending, Running, Succeeded, Failed or Unknown
 	Status     string
 	Ready      string
 	Restarts   int32
 	Age        string
 	Node       string
 	Containers []ContainerInfo
+	Labels     map[string]string
 	CreatedAt  time.Time
+	Extra      map[string]string // extra columns of config.yaml, by name
 }
 
 // DeploymentInfo represents a Kubernetes deployment for display in the TUI.
 type DeploymentInfo struct {
 	Name      string
+	UID       string
 	Namespace string
 	Ready     string
 	Replicas  int32
 	Available int32
 	Age       string
 	Image     string
+	Selector  string // label selector of the managed pods, e.g. "app=web"
 	CreatedAt time.Time
+	Extra     map[string]string // extra columns of config.yaml, by name
 }
 
 // NamespaceInfo represents a Kubernetes namespace for display in the TUI.
@@ -41,6 +61,23 @@ type NamespaceInfo struct {
 	Age    string
 }
 
+// NodeInfo represents a cluster node for display in the TUI.
+type NodeInfo struct {
+	Name   string
+	Ready  bool
+	Status string // "Ready", "NotReady", with ",SchedulingDisabled" when cordoned
+	Reason string // message of the Ready condition when not ready
+	Roles  string
+	Age    string
+}
+
+// CommandResult holds the output of a non-interactive command run in a container.
+type CommandResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+}
+
 // WatchEventType represents the type of a Kubernetes watch event.
 type WatchEventType string
 
@@ -60,6 +97,81 @@ type EventInfo struct {
 	Age       string
 	Count     int32
 	CreatedAt time.Time
+	Extra     map[string]string // extra columns of config.yaml, by name
+}
+
+// ContextInfo represents a kubeconfig context.
+type ContextInfo struct {
+	Name      string
+	Cluster   string
+	Server    string
+	User      string
+	Namespace string // default namespace of the context, may be empty
+	Current   bool   // context used by the TUI
+}
+
+// ColumnSpec is an extra column of a list view. Its value is read from the
+// raw object with a JSONPath expression ({.spec.nodeName}), or from a label
+// or an annotation.
+type ColumnSpec struct {
+	Name       string
+	JSONPath   string
+	Label      string
+	Annotation string
+}
+
+// ColumnSpecs lists the extra columns of each list view.
+type ColumnSpecs struct {
+	Pods        []ColumnSpec
+	Deployments []ColumnSpec
+	Events      []ColumnSpec
+}
+
+// ObjectRef identifies the object whose events are listed. UID, when set,
+// excludes the events of a previous object with the same name.
+type ObjectRef struct {
+	Kind string // "Pod", "Deployment"...
+	Name string
+	UID  string
+}
+
+func (r ObjectRef) String() string {
+	return r.Kind + "/" + r.Name
+}
+
+// LogOptions selects the part of a container log to fetch. Since and tail
+// limits combine like in kubectl logs.
+type LogOptions struct {
+	TailLines    int64     // last lines to fetch, all of them when < 0
+	SinceSeconds int64     // only lines newer than this many seconds, 0 for no limit
+	SinceTime    time.Time // only lines after this time, zero for no limit
+	LimitBytes   int64     // stop after this many bytes, 0 for no limit
+	Timestamps   bool      // prefix each line with its RFC3339 server timestamp
+	Previous     bool      // log of the previous container instance
+}
+
+// LogLine is one line of a followed container log.
+// Timestamp is the server-side time of the line, zero when unknown.
+type LogLine struct {
+	PodName       string
+	ContainerName string
+	Timestamp     time.Time
+	Text          string
+}
+
+// SplitLogTimestamp separates the RFC3339 prefix of a line fetched with
+// LogOptions.Timestamps from the log text. Lines without a valid prefix are
+// returned unchanged.
+func SplitLogTimestamp(line string) (time.Time, string) {
+	prefix, rest, ok := strings.Cut(line, " ")
+	if !ok {
+		prefix, rest = line, ""
+	}
+	ts, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return time.Time{}, line
+	}
+	return ts, rest
 }
 
 // WatchEvent carries a single watch event for the TUI to merge into its state.
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"io"
 	"os/exec"
 )
 
@@ -11,15 +12,34 @@ type ClusterInfo interface {
 	GetServerURL() string
 	GetNamespace() string
 	SetNamespace(ns string)
+	// SetAllNamespaces makes list and watch operations span every
+	// namespace. Other operations still target GetNamespace; actions on a
+	// row of another namespace go through InNamespace.
+	SetAllNamespaces(all bool)
+	AllNamespaces() bool
+	InNamespace(ns string) KubeGateway
 	Reconnect() error
+	// ListContexts lists the kubeconfig contexts; SwitchContext returns a
+	// new gateway on another one, in its default namespace, without writing
+	// the kubeconfig. The receiver is left untouched: calls still running
+	// on it stay on the previous cluster.
+	ListContexts() ([]ContextInfo, error)
+	SwitchContext(name string) (KubeGateway, error)
 }
 
 // PodRepository provides access to pod operations.
 type PodRepository interface {
 	ListPods(ctx context.Context) ([]PodInfo, error)
 	WatchPods(ctx context.Context) (<-chan WatchEvent, error)
-	GetPodLogs(ctx context.Context, podName, containerName string, tailLines int64, previous bool) (string, error)
+	GetPodLogs(ctx context.Context, podName, containerName string, opts LogOptions) (string, error)
+	// StreamPodLogs follows a container log from the range selected by opts.
+	// Lines always carry their server timestamp; Previous is ignored.
+	// The channel is closed when the stream ends or ctx is cancelled.
+	StreamPodLogs(ctx context.Context, podName, containerName string, opts LogOptions) (<-chan LogLine, error)
 	DeletePod(ctx context.Context, podName string) error
+	// CreateDebugContainer injects an ephemeral container running image into the pod,
+	// sharing the process namespace of targetContainer, and returns its name once running.
+	CreateDebugContainer(ctx context.Context, podName, targetContainer, image string) (string, error)
 }
 
 // DeploymentRepository provides access to deployment operations.
@@ -27,6 +47,9 @@ type DeploymentRepository interface {
 	ListDeployments(ctx context.Context) ([]DeploymentInfo, error)
 	WatchDeployments(ctx context.Context) (<-chan WatchEvent, error)
 	ScaleDeployment(ctx context.Context, name string, replicas int32) error
+	// RestartDeployment triggers a rollout of new pods, like
+	// oc rollout restart.
+	RestartDeployment(ctx context.Context, name string) error
 }
 
 // NamespaceRepository provides access to namespace operations.
@@ -34,9 +57,18 @@ type NamespaceRepository interface {
 	ListNamespaces(ctx context.Context) ([]NamespaceInfo, error)
 }
 
+// NodeRepository provides access to cluster nodes. Listing them usually
+// needs cluster-wide read access.
+type NodeRepository interface {
+	ListNodes(ctx context.Context) ([]NodeInfo, error)
+}
+
 // EventRepository provides access to event operations.
 type EventRepository interface {
 	ListEvents(ctx context.Context) ([]EventInfo, error)
+	// ListObjectEvents lists the events of a single object. The events of a
+	// deployment include those of its ReplicaSets and their pods.
+	ListObjectEvents(ctx context.Context, ref ObjectRef) ([]EventInfo, error)
 	WatchEvents(ctx context.Context) (<-chan WatchEvent, error)
 }
 
@@ -46,9 +78,32 @@ type ResourceDetailProvider interface {
 	GetDeploymentYAML(ctx context.Context, name string) (string, error)
 }
 
-// ExecProvider builds an exec.Cmd to shell into a pod.
+// ExecSession is an interactive command attached to the user's terminal.
+// Its method set matches tea.ExecCommand so the TUI can hand it to tea.Exec.
+type ExecSession interface {
+	Run() error
+	SetStdin(io.Reader)
+	SetStdout(io.Writer)
+	SetStderr(io.Writer)
+}
+
+// ExecProvider opens shells in pods, either through an external oc/kubectl
+// binary (BuildExecCmd) or through the built-in remotecommand client (NewExecSession).
+// RunCommand executes a non-interactive command and captures its output;
+// a non-zero exit status is reported in CommandResult.ExitCode, not as an error.
 type ExecProvider interface {
 	BuildExecCmd(namespace, podName, containerName, shell string) (*exec.Cmd, error)
+	NewExecSession(namespace, podName, containerName string, command []string) (ExecSession, error)
+	RunCommand(ctx context.Context, namespace, podName, containerName string, command []string) (CommandResult, error)
+}
+
+// FileTransfer copies files and directories between the local machine and a
+// container by streaming a tar archive over exec, like kubectl cp. progress is
+// called with the cumulative number of bytes transferred. It fails with
+// ErrNoTar when the container image has no tar binary.
+type FileTransfer interface {
+	CopyFromPod(ctx context.Context, namespace, podName, containerName, remotePath, localPath string, progress func(int64)) error
+	CopyToPod(ctx context.Context, namespace, podName, containerName, localPath, remotePath string, progress func(int64)) error
 }
 
 // KubeGateway is the primary port combining all cluster operations.
@@ -58,7 +113,9 @@ type KubeGateway interface {
 	PodRepository
 	DeploymentRepository
 	NamespaceRepository
+	NodeRepository
 	EventRepository
 	ResourceDetailProvider
 	ExecProvider
+	FileTransfer
 }
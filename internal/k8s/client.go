@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -27,6 +29,8 @@ type Client struct {
 	context        string
 	serverURL      string
 	namespace      string
+	allNamespaces  bool
+	extraColumns   ExtraColumns
 }
 
 // Compile-time check that Client implements domain.KubeGateway.
@@ -39,13 +43,45 @@ func (c *Client) GetServerURL() string  { return c.serverURL }
 func (c *Client) GetNamespace() string  { return c.namespace }
 func (c *Client) SetNamespace(ns string) { c.namespace = ns }
 
+func (c *Client) SetAllNamespaces(all bool) { c.allNamespaces = all }
+func (c *Client) AllNamespaces() bool       { return c.allNamespaces }
+
+// InNamespace returns a copy of the client bound to ns, sharing the
+// clientset.
+func (c *Client) InNamespace(ns string) domain.KubeGateway {
+	scoped := *c
+	scoped.namespace = ns
+	scoped.allNamespaces = false
+	return &scoped
+}
+
+// listNamespace is the namespace of list and watch operations.
+func (c *Client) listNamespace() string {
+	if c.allNamespaces {
+		return metav1.NamespaceAll
+	}
+	return c.namespace
+}
+
 // NewClient creates a K8s client from kubeconfig.
 func NewClient() (*Client, error) {
-	kubeconfigPath := os.Getenv("KUBECONFIG")
-	if kubeconfigPath == "" {
-		home, _ := os.UserHomeDir()
-		kubeconfigPath = filepath.Join(home, ".kube", "config")
+	return newClient("")
+}
+
+// kubeconfigPath returns $KUBECONFIG or ~/.kube/config.
+func kubeconfigPath() string {
+	if path := os.Getenv("KUBECONFIG"); path != "" {
+		return path
 	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".kube", "config")
+}
+
+// newClient creates a client for contextName, or for the current context of
+// the kubeconfig when empty. The context is selected through an override:
+// the kubeconfig file is never written.
+func newClient(contextName string) (*Client, error) {
+	kubeconfigPath := kubeconfigPath()
 
 	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
 		return nil, &domain.APIError{
@@ -56,7 +92,7 @@ func NewClient() (*Client, error) {
 	}
 
 	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath}
-	configOverrides := &clientcmd.ConfigOverrides{}
+	configOverrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
 	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
 
 	rawConfig, err := kubeConfig.RawConfig()
@@ -68,12 +104,22 @@ func NewClient() (*Client, error) {
 		}
 	}
 
-	if rawConfig.CurrentContext == "" {
+	if contextName == "" {
+		contextName = rawConfig.CurrentContext
+	}
+	if contextName == "" {
 		return nil, &domain.APIError{
 			Type:    domain.ErrNoContext,
 			Message: "Aucun contexte actif dans le kubeconfig.\nUtilisez : kubectl config use-context <ctx>",
 		}
 	}
+	kubeContext, ok := rawConfig.Contexts[contextName]
+	if !ok {
+		return nil, &domain.APIError{
+			Type:    domain.ErrNoContext,
+			Message: fmt.Sprintf("Contexte %q introuvable dans le kubeconfig", contextName),
+		}
+	}
 
 	restConfig, err := kubeConfig.ClientConfig()
 	if err != nil {
@@ -104,7 +150,7 @@ func NewClient() (*Client, error) {
 	}
 
 	serverURL := ""
-	if clusterInfo, ok := rawConfig.Clusters[rawConfig.Contexts[rawConfig.CurrentContext].Cluster]; ok {
+	if clusterInfo, ok := rawConfig.Clusters[kubeContext.Cluster]; ok {
 		serverURL = clusterInfo.Server
 	}
 
@@ -112,15 +158,16 @@ func NewClient() (*Client, error) {
 		clientset:      clientset,
 		config:         restConfig,
 		kubeconfigPath: kubeconfigPath,
-		context:        rawConfig.CurrentContext,
+		context:        contextName,
 		serverURL:      serverURL,
 		namespace:      namespace,
 	}, nil
 }
 
-// Reconnect reloads the kubeconfig from disk and recreates the clientset.
+// Reconnect reloads the kubeconfig from disk and recreates the clientset,
+// staying on the context selected in the TUI.
 func (c *Client) Reconnect() error {
-	newClient, err := NewClient()
+	newClient, err := newClient(c.context)
 	if err != nil {
 		return err
 	}
@@ -131,6 +178,46 @@ func (c *Client) Reconnect() error {
 	return nil
 }
 
+// ListContexts lists the contexts of the kubeconfig, sorted by name.
+func (c *Client) ListContexts() ([]domain.ContextInfo, error) {
+	rawConfig, err := clientcmd.LoadFromFile(c.kubeconfigPath)
+	if err != nil {
+		return nil, &domain.APIError{
+			Type:    domain.ErrBadKubeconfig,
+			Message: fmt.Sprintf("Kubeconfig invalide : %v", err),
+			Err:     err,
+		}
+	}
+	contexts := make([]domain.ContextInfo, 0, len(rawConfig.Contexts))
+	for name, ctx := range rawConfig.Contexts {
+		server := ""
+		if cluster, ok := rawConfig.Clusters[ctx.Cluster]; ok {
+			server = cluster.Server
+		}
+		contexts = append(contexts, domain.ContextInfo{
+			Name:      name,
+			Cluster:   ctx.Cluster,
+			Server:    server,
+			User:      ctx.AuthInfo,
+			Namespace: ctx.Namespace,
+			Current:   name == c.context,
+		})
+	}
+	sort.Slice(contexts, func(i, j int) bool { return contexts[i].Name < contexts[j].Name })
+	return contexts, nil
+}
+
+// SwitchContext builds a client for another kubeconfig context, in its
+// default namespace. The kubeconfig and c are left untouched.
+func (c *Client) SwitchContext(name string) (domain.KubeGateway, error) {
+	newClient, err := newClient(name)
+	if err != nil {
+		return nil, err
+	}
+	newClient.extraColumns = c.extraColumns
+	return newClient, nil
+}
+
 // TestConnection makes a lightweight API call to verify connectivity.
 func (c *Client) TestConnection(ctx context.Context) error {
 	_, err := c.clientset.Discovery().ServerVersion()
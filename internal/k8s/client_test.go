@@ -3,6 +3,8 @@ package k8s
 import (
 	"errors"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -253,3 +255,123 @@ func contains(s, sub string) bool {
 	}
 	return false
 }
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://dev.example.com:6443
+- name: prod-cluster
+  cluster:
+    server: https://prod.example.com:6443
+users:
+- name: alice
+  user:
+    token: dev-token
+- name: alice-prod
+  user:
+    token: prod-token
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: alice
+    namespace: team-a
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: alice-prod
+`
+
+func writeTestKubeconfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", path)
+	return path
+}
+
+func TestListContexts(t *testing.T) {
+	writeTestKubeconfig(t)
+	c, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	contexts, err := c.ListContexts()
+	if err != nil {
+		t.Fatalf("ListContexts() error = %v", err)
+	}
+	if len(contexts) != 2 {
+		t.Fatalf("len(contexts) = %d, want 2", len(contexts))
+	}
+	dev := contexts[0]
+	if dev.Name != "dev" || dev.Cluster != "dev-cluster" || dev.User != "alice" || dev.Namespace != "team-a" || !dev.Current {
+		t.Errorf("contexts[0] = %+v", dev)
+	}
+	if contexts[1].Name != "prod" || contexts[1].Current || contexts[1].Server != "https://prod.example.com:6443" {
+		t.Errorf("contexts[1] = %+v", contexts[1])
+	}
+}
+
+func TestSwitchContext_KeepsKubeconfigUntouched(t *testing.T) {
+	path := writeTestKubeconfig(t)
+	c, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if c.GetNamespace() != "team-a" {
+		t.Fatalf("namespace = %q, want team-a", c.GetNamespace())
+	}
+
+	gw, err := c.SwitchContext("prod")
+	if err != nil {
+		t.Fatalf("SwitchContext() error = %v", err)
+	}
+	if gw.GetContext() != "prod" || gw.GetServerURL() != "https://prod.example.com:6443" {
+		t.Errorf("context = %q, server = %q", gw.GetContext(), gw.GetServerURL())
+	}
+	if gw.GetNamespace() != "default" {
+		t.Errorf("namespace = %q, want default", gw.GetNamespace())
+	}
+	if c.GetContext() != "dev" || c.GetNamespace() != "team-a" {
+		t.Errorf("the previous client moved to %q/%q, want it untouched", c.GetContext(), c.GetNamespace())
+	}
+
+	// Reconnect stays on the selected context
+	if err := gw.Reconnect(); err != nil {
+		t.Fatalf("Reconnect() error = %v", err)
+	}
+	if gw.GetContext() != "prod" {
+		t.Errorf("context after Reconnect = %q, want prod", gw.GetContext())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != testKubeconfig {
+		t.Error("kubeconfig should not be written")
+	}
+}
+
+func TestSwitchContext_Unknown(t *testing.T) {
+	writeTestKubeconfig(t)
+	c, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = c.SwitchContext("staging")
+	var apiErr *domain.APIError
+	if !errors.As(err, &apiErr) || apiErr.Type != domain.ErrNoContext {
+		t.Fatalf("SwitchContext() error = %v, want ErrNoContext", err)
+	}
+	if c.GetContext() != "dev" {
+		t.Errorf("context = %q, want dev unchanged", c.GetContext())
+	}
+}
@@ -0,0 +1,139 @@
+package k8s
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// extraColumn reads the value of an extra column from the raw object: a
+// JSONPath template, a label or an annotation.
+type extraColumn struct {
+	name       string
+	label      string
+	annotation string
+	path       *jsonPath
+}
+
+// jsonPath is a template parsed once and shared by the copies of the
+// client. A JSONPath keeps state while executing, so concurrent watches
+// take turns.
+type jsonPath struct {
+	mu sync.Mutex
+	jp *jsonpath.JSONPath
+}
+
+func (p *jsonPath) execute(data interface{}) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var buf bytes.Buffer
+	if p.jp.Execute(&buf, data) != nil {
+		return "", false
+	}
+	return buf.String(), true
+}
+
+// ExtraColumns are the checked extra columns of each list view.
+type ExtraColumns struct {
+	pods        []extraColumn
+	deployments []extraColumn
+	events      []extraColumn
+}
+
+// CompileExtraColumns checks the extra columns: every column has a name of
+// its own and exactly one of jsonpath, label or annotation, and the
+// JSONPath expressions parse. Braces are optional: .spec.nodeName and
+// {.spec.nodeName} are the same.
+func CompileExtraColumns(cfg domain.ColumnSpecs) (ExtraColumns, error) {
+	var cols ExtraColumns
+	var err error
+	if cols.pods, err = compileColumns("pods", cfg.Pods); err != nil {
+		return ExtraColumns{}, err
+	}
+	if cols.deployments, err = compileColumns("deployments", cfg.Deployments); err != nil {
+		return ExtraColumns{}, err
+	}
+	if cols.events, err = compileColumns("events", cfg.Events); err != nil {
+		return ExtraColumns{}, err
+	}
+	return cols, nil
+}
+
+func compileColumns(view string, specs []domain.ColumnSpec) ([]extraColumn, error) {
+	var cols []extraColumn
+	seen := make(map[string]bool, len(specs))
+	for i, s := range specs {
+		where := fmt.Sprintf("columns.%s[%d]", view, i)
+		if s.Name == "" {
+			return nil, fmt.Errorf("%s : nom manquant", where)
+		}
+		if seen[s.Name] {
+			return nil, fmt.Errorf("%s (%s) : colonne déjà déclarée", where, s.Name)
+		}
+		seen[s.Name] = true
+		set := 0
+		for _, v := range []string{s.JSONPath, s.Label, s.Annotation} {
+			if v != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("%s (%s) : indiquer un seul de jsonpath, label ou annotation", where, s.Name)
+		}
+		col := extraColumn{name: s.Name, label: s.Label, annotation: s.Annotation}
+		if s.JSONPath != "" {
+			template := s.JSONPath
+			if !strings.Contains(template, "{") {
+				template = "{" + template + "}"
+			}
+			jp := jsonpath.New(s.Name).AllowMissingKeys(true)
+			if err := jp.Parse(template); err != nil {
+				return nil, fmt.Errorf("%s (%s) : jsonpath invalide : %v", where, s.Name, err)
+			}
+			col.path = &jsonPath{jp: jp}
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// SetExtraColumns makes the list and watch calls fill the Extra field of
+// the pods, deployments and events.
+func (c *Client) SetExtraColumns(cols ExtraColumns) { c.extraColumns = cols }
+
+// extraFields evaluates the columns against obj. The object is converted
+// to its JSON form only when a JSONPath column needs it; a path that
+// matches nothing gives an empty value.
+func extraFields(cols []extraColumn, obj runtime.Object, meta metav1.Object) map[string]string {
+	if len(cols) == 0 {
+		return nil
+	}
+	fields := make(map[string]string, len(cols))
+	var data map[string]interface{}
+	for _, col := range cols {
+		switch {
+		case col.label != "":
+			fields[col.name] = meta.GetLabels()[col.label]
+		case col.annotation != "":
+			fields[col.name] = meta.GetAnnotations()[col.annotation]
+		default:
+			if data == nil {
+				var err error
+				if data, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+					continue
+				}
+			}
+			if v, ok := col.path.execute(data); ok {
+				fields[col.name] = v
+			}
+		}
+	}
+	return fields
+}
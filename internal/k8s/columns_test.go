@@ -0,0 +1,119 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func TestCompileExtraColumns_Errors(t *testing.T) {
+	tests := []struct {
+		cfg  domain.ColumnSpecs
+		want string
+	}{
+		{domain.ColumnSpecs{Pods: []domain.ColumnSpec{{JSONPath: ".spec.nodeName"}}}, "columns.pods[0] : nom manquant"},
+		{domain.ColumnSpecs{Events: []domain.ColumnSpec{{Name: "x"}}}, "un seul de jsonpath, label ou annotation"},
+		{domain.ColumnSpecs{Pods: []domain.ColumnSpec{{Name: "x", Label: "app", Annotation: "a"}}}, "un seul"},
+		{domain.ColumnSpecs{Deployments: []domain.ColumnSpec{{Name: "x", JSONPath: "{.spec["}}}, "columns.deployments[0] (x) : jsonpath invalide"},
+		{domain.ColumnSpecs{Pods: []domain.ColumnSpec{{Name: "IP", Label: "ip"}, {Name: "IP", JSONPath: ".status.podIP"}}}, "columns.pods[1] (IP) : colonne déjà déclarée"},
+	}
+	for _, tt := range tests {
+		if _, err := CompileExtraColumns(tt.cfg); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("CompileExtraColumns(%+v) = %v, want %q", tt.cfg, err, tt.want)
+		}
+	}
+}
+
+func TestListPods_ExtraColumns(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web-1", Namespace: "default",
+			Labels:      map[string]string{"team": "payments"},
+			Annotations: map[string]string{"owner": "alice"},
+		},
+		Spec:   corev1.PodSpec{NodeName: "worker-2", Containers: []corev1.Container{{Name: "app", Image: "web:1.4"}}},
+		Status: corev1.PodStatus{PodIP: "10.0.0.7", QOSClass: corev1.PodQOSBurstable},
+	}
+	c, _ := newFakeClient(pod)
+	cols, err := CompileExtraColumns(domain.ColumnSpecs{Pods: []domain.ColumnSpec{
+		{Name: "QOS", JSONPath: ".status.qosClass"},
+		{Name: "IP", JSONPath: "{.status.podIP}"},
+		{Name: "TAG", JSONPath: "{.spec.containers[0].image}"},
+		{Name: "TEAM", Label: "team"},
+		{Name: "OWNER", Annotation: "owner"},
+		{Name: "MISSING", JSONPath: ".status.nominatedNodeName"},
+	}})
+	if err != nil {
+		t.Fatalf("CompileExtraColumns: %v", err)
+	}
+	c.SetExtraColumns(cols)
+
+	pods, err := c.ListPods(context.Background())
+	if err != nil || len(pods) != 1 {
+		t.Fatalf("ListPods() = %v, %v", pods, err)
+	}
+	want := map[string]string{
+		"QOS": "Burstable", "IP": "10.0.0.7", "TAG": "web:1.4", "TEAM": "payments", "OWNER": "alice", "MISSING": "",
+	}
+	for k, v := range want {
+		if got, ok := pods[0].Extra[k]; !ok || got != v {
+			t.Errorf("Extra[%s] = %q, want %q", k, got, v)
+		}
+	}
+
+	// Scoped copies keep the columns
+	scoped := c.InNamespace("default").(*Client)
+	if pods, _ := scoped.ListPods(context.Background()); pods[0].Extra["TEAM"] != "payments" {
+		t.Error("InNamespace should keep the extra columns")
+	}
+}
+
+func TestListDeployments_ExtraColumns(t *testing.T) {
+	replicas := int32(2)
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType},
+		},
+	}
+	c, _ := newFakeClient(dep)
+	cols, _ := CompileExtraColumns(domain.ColumnSpecs{Deployments: []domain.ColumnSpec{
+		{Name: "STRATEGY", JSONPath: ".spec.strategy.type"},
+	}})
+	c.SetExtraColumns(cols)
+
+	deps, err := c.ListDeployments(context.Background())
+	if err != nil || len(deps) != 1 || deps[0].Extra["STRATEGY"] != "RollingUpdate" {
+		t.Errorf("ListDeployments() = %+v, %v; want the strategy column", deps, err)
+	}
+}
+
+func TestListEvents_ExtraColumns(t *testing.T) {
+	evt := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web-1.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "default"},
+		Source:         corev1.EventSource{Component: "kubelet"},
+		Reason:         "BackOff",
+	}
+	c, _ := newFakeClient(evt)
+	cols, _ := CompileExtraColumns(domain.ColumnSpecs{Events: []domain.ColumnSpec{
+		{Name: "SOURCE", JSONPath: ".source.component"},
+	}})
+	c.SetExtraColumns(cols)
+
+	events, err := c.ListEvents(context.Background())
+	if err != nil || len(events) != 1 || events[0].Extra["SOURCE"] != "kubelet" {
+		t.Errorf("ListEvents() = %+v, %v; want the source column", events, err)
+	}
+	events, err = c.ListObjectEvents(context.Background(), domain.ObjectRef{Kind: "Pod", Name: "web-1"})
+	if err != nil || len(events) != 1 || events[0].Extra["SOURCE"] != "kubelet" {
+		t.Errorf("ListObjectEvents() = %+v, %v; want the source column", events, err)
+	}
+}
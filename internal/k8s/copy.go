@@ -0,0 +1,290 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// CopyFromPod downloads remotePath (file or directory) from a container by
+// running `tar cf -` over exec and extracting the archive locally. When
+// localPath is an existing directory the copy is placed inside it.
+func (c *Client) CopyFromPod(ctx context.Context, namespace, podName, containerName, remotePath, localPath string, progress func(int64)) error {
+	dir, base, err := splitRemotePath(remotePath)
+	if err != nil {
+		return err
+	}
+	executor, err := c.newCopyExecutor(namespace, podName, containerName, []string{"tar", "cf", "-", "-C", dir, base}, false)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	var stderr bytes.Buffer
+	streamErr := make(chan error, 1)
+	go func() {
+		err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: pw, Stderr: &stderr})
+		pw.CloseWithError(err)
+		streamErr <- err
+	}()
+
+	n, extractErr := extractTar(&progressReader{r: pr, fn: progress}, base, localCopyTarget(localPath, base))
+	// Unblock the stream if extraction stopped before the end of the archive.
+	pr.Close()
+	if err := <-streamErr; err != nil {
+		return c.copyError(err, stderr.String())
+	}
+	if extractErr != nil {
+		return extractErr
+	}
+	if n == 0 {
+		return fmt.Errorf("aucun fichier reçu pour %s", remotePath)
+	}
+	return nil
+}
+
+// CopyToPod uploads localPath (file or directory) into a container by
+// streaming a tar archive to `tar xmf -` over exec. A remotePath ending in
+// "/" is a directory and keeps the local name; otherwise the copy is renamed
+// to the last element of remotePath.
+func (c *Client) CopyToPod(ctx context.Context, namespace, podName, containerName, localPath, remotePath string, progress func(int64)) error {
+	if _, err := os.Stat(localPath); err != nil {
+		return fmt.Errorf("fichier local : %w", err)
+	}
+	var dir, name string
+	if strings.HasSuffix(remotePath, "/") {
+		dir, name = path.Clean(remotePath), filepath.Base(localPath)
+	} else {
+		var err error
+		if dir, name, err = splitRemotePath(remotePath); err != nil {
+			return err
+		}
+	}
+	executor, err := c.newCopyExecutor(namespace, podName, containerName, []string{"tar", "xmf", "-", "-C", dir}, true)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	writeErr := make(chan error, 1)
+	go func() {
+		err := writeTar(&progressWriter{w: pw, fn: progress}, localPath, name)
+		pw.CloseWithError(err)
+		writeErr <- err
+	}()
+
+	var stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdin: pr, Stderr: &stderr})
+	// Unblock the archive writer if the remote side stopped reading early.
+	pr.Close()
+	wErr := <-writeErr
+	if err != nil {
+		return c.copyError(err, stderr.String())
+	}
+	if wErr != nil && !errors.Is(wErr, io.ErrClosedPipe) {
+		return wErr
+	}
+	return nil
+}
+
+func (c *Client) newCopyExecutor(namespace, podName, containerName string, command []string, upload bool) (remotecommand.Executor, error) {
+	if c.config == nil {
+		return nil, fmt.Errorf("copie indisponible : configuration client absente")
+	}
+	u, err := execURL(c.config.Host, namespace, podName, &corev1.PodExecOptions{
+		Container: containerName,
+		Command:   command,
+		Stdin:     upload,
+		Stdout:    !upload,
+		Stderr:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	executor, err := NewExecutorFunc(c.config, u)
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+	return executor, nil
+}
+
+// copyError turns a failed tar stream into a readable error, detecting
+// images that ship without tar (exit 126/127 or a runtime "not found").
+func (c *Client) copyError(err error, stderr string) error {
+	stderr = strings.TrimSpace(stderr)
+	msg := err.Error() + "\n" + stderr
+	if strings.Contains(msg, `"tar": executable file not found`) || strings.Contains(msg, "tar: not found") {
+		return domain.ErrNoTar
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		if code := exitErr.ExitStatus(); code == 126 || code == 127 {
+			return domain.ErrNoTar
+		}
+		if stderr != "" {
+			return fmt.Errorf("tar a échoué : %s", stderr)
+		}
+		return fmt.Errorf("tar a échoué (exit %d)", exitErr.ExitStatus())
+	}
+	return classifyError(err, c.serverURL)
+}
+
+// splitRemotePath splits a container path into the directory tar runs in
+// and the element to archive or create.
+func splitRemotePath(p string) (dir, base string, err error) {
+	p = path.Clean(p)
+	if p == "/" || p == "." || p == "" {
+		return "", "", fmt.Errorf("chemin distant invalide : %q", p)
+	}
+	return path.Dir(p), path.Base(p), nil
+}
+
+func localCopyTarget(localPath, base string) string {
+	if fi, err := os.Stat(localPath); err == nil && fi.IsDir() {
+		return filepath.Join(localPath, base)
+	}
+	return localPath
+}
+
+// extractTar writes the entries of a tar archive rooted at prefix under
+// target and returns the number of entries written. Entries escaping target,
+// symlinks and special files are skipped, as kubectl cp does.
+func extractTar(r io.Reader, prefix, target string) (int, error) {
+	tr := tar.NewReader(r)
+	n := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, fmt.Errorf("archive tar invalide : %w", err)
+		}
+
+		name := path.Clean(hdr.Name)
+		var rel string
+		switch {
+		case name == prefix:
+		case strings.HasPrefix(name, prefix+"/"):
+			rel = strings.TrimPrefix(name, prefix+"/")
+			if !filepath.IsLocal(rel) {
+				continue
+			}
+		default:
+			continue
+		}
+		dest := filepath.Join(target, filepath.FromSlash(rel))
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(dest, 0o755); err != nil {
+				return n, err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+				return n, err
+			}
+			f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode)&0o777)
+			if err != nil {
+				return n, err
+			}
+			_, err = io.Copy(f, tr)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return n, err
+			}
+		default:
+			continue
+		}
+		n++
+	}
+}
+
+// writeTar archives localPath into w, naming the root entry name.
+func writeTar(w io.Writer, localPath, name string) error {
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(localPath, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() && !fi.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(name, filepath.ToSlash(rel))
+		if fi.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// progressReader reports the cumulative number of bytes read.
+type progressReader struct {
+	r     io.Reader
+	fn    func(int64)
+	total int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 && p.fn != nil {
+		p.total += int64(n)
+		p.fn(p.total)
+	}
+	return n, err
+}
+
+// progressWriter reports the cumulative number of bytes written.
+type progressWriter struct {
+	w     io.Writer
+	fn    func(int64)
+	total int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	if n > 0 && p.fn != nil {
+		p.total += int64(n)
+		p.fn(p.total)
+	}
+	return n, err
+}
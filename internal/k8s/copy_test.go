@@ -0,0 +1,222 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/rest"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newCopyTestClient() *Client {
+	return &Client{namespace: "default", config: &rest.Config{Host: "https://fake:6443"}}
+}
+
+func buildTar(t *testing.T, entries map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range entries {
+		hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(name, "/") {
+			hdr = &tar.Header{Name: name, Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			_, _ = io.WriteString(tw, content)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func readTar(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	entries := make(map[string]string)
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(tr)
+		entries[hdr.Name] = string(content)
+	}
+}
+
+func TestCopyFromPod_File(t *testing.T) {
+	fake := &fakeExecutor{output: buildTar(t, map[string]string{"heap.hprof": "dump"})}
+	gotURL := withFakeExecutor(t, fake)
+	dst := filepath.Join(t.TempDir(), "local.hprof")
+
+	var reported int64
+	err := newCopyTestClient().CopyFromPod(context.Background(), "myns", "web-1", "app", "/tmp/heap.hprof", dst, func(n int64) { reported = n })
+	if err != nil {
+		t.Fatalf("CopyFromPod() error = %v", err)
+	}
+
+	data, err := os.ReadFile(dst)
+	if err != nil || string(data) != "dump" {
+		t.Errorf("local file = %q, %v; want dump", data, err)
+	}
+	if reported == 0 {
+		t.Error("progress should have been reported")
+	}
+	if got := strings.Join(gotURL.Query()["command"], " "); got != "tar cf - -C /tmp heap.hprof" {
+		t.Errorf("command = %q", got)
+	}
+	if gotURL.Query().Get("container") != "app" {
+		t.Errorf("container = %q, want app", gotURL.Query().Get("container"))
+	}
+}
+
+func TestCopyFromPod_DirectoryIntoExistingDir(t *testing.T) {
+	fake := &fakeExecutor{output: buildTar(t, map[string]string{
+		"conf/":            "",
+		"conf/app.yaml":    "a: 1",
+		"conf/sub/x.txt":   "x",
+		"conf/../evil.txt": "nope",
+	})}
+	withFakeExecutor(t, fake)
+	dst := t.TempDir()
+
+	if err := newCopyTestClient().CopyFromPod(context.Background(), "myns", "web-1", "", "/etc/conf", dst, nil); err != nil {
+		t.Fatalf("CopyFromPod() error = %v", err)
+	}
+
+	if data, _ := os.ReadFile(filepath.Join(dst, "conf", "app.yaml")); string(data) != "a: 1" {
+		t.Errorf("conf/app.yaml = %q", data)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dst, "conf", "sub", "x.txt")); string(data) != "x" {
+		t.Errorf("conf/sub/x.txt = %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "evil.txt")); err == nil {
+		t.Error("entries escaping the target must be skipped")
+	}
+}
+
+func TestCopyFromPod_TarMissing(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"exit 127", utilexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127}},
+		{"runtime", errors.New(`exec failed: exec: "tar": executable file not found in $PATH: unknown`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeExecutor(t, &fakeExecutor{err: tt.err})
+			dst := filepath.Join(t.TempDir(), "out")
+			err := newCopyTestClient().CopyFromPod(context.Background(), "myns", "web-1", "", "/tmp/f", dst, nil)
+			if !errors.Is(err, domain.ErrNoTar) {
+				t.Errorf("err = %v, want ErrNoTar", err)
+			}
+		})
+	}
+}
+
+func TestCopyFromPod_RemoteError(t *testing.T) {
+	withFakeExecutor(t, &fakeExecutor{
+		stderr: "tar: /tmp/nope: No such file or directory\n",
+		err:    utilexec.CodeExitError{Err: errors.New("exit 2"), Code: 2},
+	})
+	err := newCopyTestClient().CopyFromPod(context.Background(), "myns", "web-1", "", "/tmp/nope", filepath.Join(t.TempDir(), "out"), nil)
+	if err == nil || !strings.Contains(err.Error(), "No such file or directory") {
+		t.Errorf("err = %v, want tar stderr in message", err)
+	}
+}
+
+func TestCopyFromPod_InvalidPath(t *testing.T) {
+	withFakeExecutor(t, &fakeExecutor{})
+	if err := newCopyTestClient().CopyFromPod(context.Background(), "myns", "web-1", "", "/", t.TempDir(), nil); err == nil {
+		t.Error("expected error for /")
+	}
+}
+
+func TestCopyToPod_FileRenamed(t *testing.T) {
+	fake := &fakeExecutor{}
+	gotURL := withFakeExecutor(t, fake)
+	src := filepath.Join(t.TempDir(), "fix.yaml")
+	if err := os.WriteFile(src, []byte("key: value"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var reported int64
+	err := newCopyTestClient().CopyToPod(context.Background(), "myns", "web-1", "", src, "/etc/app/config.yaml", func(n int64) { reported = n })
+	if err != nil {
+		t.Fatalf("CopyToPod() error = %v", err)
+	}
+
+	entries := readTar(t, fake.stdin)
+	if entries["config.yaml"] != "key: value" {
+		t.Errorf("archive = %v, want config.yaml", entries)
+	}
+	if got := strings.Join(gotURL.Query()["command"], " "); got != "tar xmf - -C /etc/app" {
+		t.Errorf("command = %q", got)
+	}
+	if gotURL.Query().Get("stdin") != "true" {
+		t.Error("upload must open stdin")
+	}
+	if reported != int64(len(fake.stdin)) {
+		t.Errorf("progress = %d, want %d", reported, len(fake.stdin))
+	}
+}
+
+func TestCopyToPod_DirectoryIntoRemoteDir(t *testing.T) {
+	fake := &fakeExecutor{}
+	withFakeExecutor(t, fake)
+	src := filepath.Join(t.TempDir(), "static")
+	if err := os.MkdirAll(filepath.Join(src, "css"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "css", "site.css"), []byte("body{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := newCopyTestClient().CopyToPod(context.Background(), "myns", "web-1", "", src, "/srv/", nil); err != nil {
+		t.Fatalf("CopyToPod() error = %v", err)
+	}
+
+	entries := readTar(t, fake.stdin)
+	if entries["static/css/site.css"] != "body{}" {
+		t.Errorf("archive = %v, want static/css/site.css", entries)
+	}
+	if _, ok := entries["static/"]; !ok {
+		t.Errorf("archive = %v, want static/ directory entry", entries)
+	}
+}
+
+func TestCopyToPod_TarMissing(t *testing.T) {
+	withFakeExecutor(t, &fakeExecutor{err: utilexec.CodeExitError{Err: errors.New("exit 126"), Code: 126}})
+	src := filepath.Join(t.TempDir(), "f")
+	_ = os.WriteFile(src, []byte("x"), 0o644)
+
+	err := newCopyTestClient().CopyToPod(context.Background(), "myns", "web-1", "", src, "/tmp/f", nil)
+	if !errors.Is(err, domain.ErrNoTar) {
+		t.Errorf("err = %v, want ErrNoTar", err)
+	}
+}
+
+func TestCopyToPod_MissingLocalFile(t *testing.T) {
+	withFakeExecutor(t, &fakeExecutor{})
+	err := newCopyTestClient().CopyToPod(context.Background(), "myns", "web-1", "", "/nonexistent/file", "/tmp/", nil)
+	if err == nil || !strings.Contains(err.Error(), "fichier local") {
+		t.Errorf("err = %v, want local file error", err)
+	}
+}
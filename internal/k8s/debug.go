@@ -0,0 +1,82 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Polling settings while waiting for an ephemeral container to start.
+// Variables so tests can shorten them.
+var (
+	debugPollInterval = 500 * time.Millisecond
+	debugStartTimeout = 60 * time.Second
+)
+
+// CreateDebugContainer injects an ephemeral container into a pod through the
+// ephemeralcontainers subresource and waits until it is running.
+// When targetContainer is set, the debug container shares its process namespace.
+func (c *Client) CreateDebugContainer(ctx context.Context, podName, targetContainer, image string) (string, error) {
+	pods := c.clientset.CoreV1().Pods(c.namespace)
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", classifyError(err, c.serverURL)
+	}
+
+	name := "debugger-" + utilrand.String(5)
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    image,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		},
+		TargetContainerName: targetContainer,
+	})
+	if _, err := pods.UpdateEphemeralContainers(ctx, podName, pod, metav1.UpdateOptions{}); err != nil {
+		return "", classifyError(err, c.serverURL)
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, debugPollInterval, debugStartTimeout, true, func(ctx context.Context) (bool, error) {
+		p, err := pods.Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, classifyError(err, c.serverURL)
+		}
+		for _, cs := range p.Status.EphemeralContainerStatuses {
+			if cs.Name != name {
+				continue
+			}
+			switch {
+			case cs.State.Running != nil:
+				return true, nil
+			case cs.State.Terminated != nil:
+				return false, fmt.Errorf("conteneur de debug %s terminé : %s", name, cs.State.Terminated.Reason)
+			case cs.State.Waiting != nil && isImagePullFailure(cs.State.Waiting.Reason):
+				return false, fmt.Errorf("image de debug %s : %s", image, cs.State.Waiting.Reason)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		if wait.Interrupted(err) {
+			return "", fmt.Errorf("conteneur de debug %s pas démarré après %s", name, debugStartTimeout)
+		}
+		return "", err
+	}
+	return name, nil
+}
+
+func isImagePullFailure(reason string) bool {
+	switch reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+func shortDebugPolling(t *testing.T) {
+	t.Helper()
+	interval, timeout := debugPollInterval, debugStartTimeout
+	t.Cleanup(func() { debugPollInterval, debugStartTimeout = interval, timeout })
+	debugPollInterval = 5 * time.Millisecond
+	debugStartTimeout = 100 * time.Millisecond
+}
+
+// setEphemeralState makes every pod GET report the given state for all
+// ephemeral containers declared in the stored pod.
+func setEphemeralState(c *Client, state corev1.ContainerState) {
+	cs := c.clientset.(interface {
+		PrependReactor(verb, resource string, fn k8sTesting.ReactionFunc)
+		Tracker() k8sTesting.ObjectTracker
+	})
+	cs.PrependReactor("get", "pods", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		get := action.(k8sTesting.GetAction)
+		obj, err := cs.Tracker().Get(corev1.SchemeGroupVersion.WithResource("pods"), get.GetNamespace(), get.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		pod := obj.(*corev1.Pod).DeepCopy()
+		for _, ec := range pod.Spec.EphemeralContainers {
+			pod.Status.EphemeralContainerStatuses = append(pod.Status.EphemeralContainerStatuses,
+				corev1.ContainerStatus{Name: ec.Name, State: state})
+		}
+		return true, pod, nil
+	})
+}
+
+func TestCreateDebugContainer_InjectsEphemeralContainer(t *testing.T) {
+	shortDebugPolling(t)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "distroless"}}},
+	}
+	c, cs := newFakeClient(pod)
+	setEphemeralState(c, corev1.ContainerState{Running: &corev1.ContainerStateRunning{}})
+
+	name, err := c.CreateDebugContainer(context.Background(), "web-1", "app", "busybox:1.36")
+	if err != nil {
+		t.Fatalf("CreateDebugContainer() error = %v", err)
+	}
+	if !strings.HasPrefix(name, "debugger-") {
+		t.Errorf("name = %q, want debugger-* prefix", name)
+	}
+
+	var updated *corev1.Pod
+	for _, a := range cs.Actions() {
+		if a.GetVerb() == "update" && a.GetSubresource() == "ephemeralcontainers" {
+			updated = a.(k8sTesting.UpdateAction).GetObject().(*corev1.Pod)
+		}
+	}
+	if updated == nil {
+		t.Fatal("expected an update on the ephemeralcontainers subresource")
+	}
+	if len(updated.Spec.EphemeralContainers) != 1 {
+		t.Fatalf("EphemeralContainers = %d, want 1", len(updated.Spec.EphemeralContainers))
+	}
+	ec := updated.Spec.EphemeralContainers[0]
+	if ec.Image != "busybox:1.36" || ec.TargetContainerName != "app" || ec.Name != name {
+		t.Errorf("ephemeral container = %+v", ec)
+	}
+	if !ec.Stdin || !ec.TTY {
+		t.Error("ephemeral container should have stdin and tty")
+	}
+}
+
+func TestCreateDebugContainer_ImagePullFailure(t *testing.T) {
+	shortDebugPolling(t)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}}
+	c, _ := newFakeClient(pod)
+	setEphemeralState(c, corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}})
+
+	_, err := c.CreateDebugContainer(context.Background(), "web-1", "", "nope:latest")
+	if err == nil || !strings.Contains(err.Error(), "ImagePullBackOff") {
+		t.Errorf("err = %v, want ImagePullBackOff error", err)
+	}
+}
+
+func TestCreateDebugContainer_Timeout(t *testing.T) {
+	shortDebugPolling(t)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}}
+	c, _ := newFakeClient(pod)
+
+	_, err := c.CreateDebugContainer(context.Background(), "web-1", "", "busybox")
+	if err == nil || !strings.Contains(err.Error(), "pas démarré") {
+		t.Errorf("err = %v, want timeout error", err)
+	}
+}
+
+func TestCreateDebugContainer_PodNotFound(t *testing.T) {
+	c, _ := newFakeClient()
+	if _, err := c.CreateDebugContainer(context.Background(), "missing", "", "busybox"); err == nil {
+		t.Fatal("expected error for missing pod")
+	}
+}
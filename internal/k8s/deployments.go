@@ -3,15 +3,17 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
 func (c *Client) ListDeployments(ctx context.Context) ([]domain.DeploymentInfo, error) {
-	depList, err := c.clientset.AppsV1().Deployments(c.namespace).List(ctx, metav1.ListOptions{
+	depList, err := c.clientset.AppsV1().Deployments(c.listNamespace()).List(ctx, metav1.ListOptions{
 		Limit: 500,
 	})
 	if err != nil {
@@ -30,20 +32,23 @@ func (c *Client) ListDeployments(ctx context.Context) ([]domain.DeploymentInfo,
 		}
 		deps = append(deps, domain.DeploymentInfo{
 			Name:      dep.Name,
+			UID:       string(dep.UID),
 			Namespace: dep.Namespace,
 			Ready:     fmt.Sprintf("%d/%d", dep.Status.ReadyReplicas, replicas),
 			Replicas:  replicas,
 			Available: dep.Status.AvailableReplicas,
 			Age:       formatAge(dep.CreationTimestamp.Time),
 			Image:     image,
+			Selector:  deploymentSelector(dep),
 			CreatedAt: dep.CreationTimestamp.Time,
+			Extra:     extraFields(c.extraColumns.deployments, &dep, &dep),
 		})
 	}
 	return deps, nil
 }
 
 func (c *Client) WatchDeployments(ctx context.Context) (<-chan domain.WatchEvent, error) {
-	watcher, err := c.clientset.AppsV1().Deployments(c.namespace).Watch(ctx, metav1.ListOptions{})
+	watcher, err := c.clientset.AppsV1().Deployments(c.listNamespace()).Watch(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, classifyError(err, c.serverURL)
 	}
@@ -73,13 +78,16 @@ func (c *Client) WatchDeployments(ctx context.Context) (<-chan domain.WatchEvent
 				}
 				info := domain.DeploymentInfo{
 					Name:      dep.Name,
+					UID:       string(dep.UID),
 					Namespace: dep.Namespace,
 					Ready:     fmt.Sprintf("%d/%d", dep.Status.ReadyReplicas, replicas),
 					Replicas:  replicas,
 					Available: dep.Status.AvailableReplicas,
 					Age:       formatAge(dep.CreationTimestamp.Time),
 					Image:     image,
+					Selector:  deploymentSelector(*dep),
 					CreatedAt: dep.CreationTimestamp.Time,
+					Extra:     extraFields(c.extraColumns.deployments, dep, dep),
 				}
 				wType := domain.WatchEventType(string(event.Type))
 				select {
@@ -105,3 +113,26 @@ func (c *Client) ScaleDeployment(ctx context.Context, name string, replicas int3
 	_, err = c.clientset.AppsV1().Deployments(c.namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
 	return classifyError(err, c.serverURL)
 }
+
+// restartedAtAnnotation is the pod template annotation oc rollout restart
+// sets; changing it makes the deployment roll out new pods.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+func (c *Client) RestartDeployment(ctx context.Context, name string) error {
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339))
+	_, err := c.clientset.AppsV1().Deployments(c.namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return classifyError(err, c.serverURL)
+}
+
+// deploymentSelector renders the pod selector of a deployment in label selector syntax.
+func deploymentSelector(dep appsv1.Deployment) string {
+	if dep.Spec.Selector == nil {
+		return ""
+	}
+	sel, err := metav1.LabelSelectorAsSelector(dep.Spec.Selector)
+	if err != nil {
+		return ""
+	}
+	return sel.String()
+}
@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		want     string
+	}{
+		{"nil", nil, ""},
+		{"match labels", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web", "tier": "front"}}, "app=web,tier=front"},
+		{"expressions", &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "web"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "track", Operator: metav1.LabelSelectorOpIn, Values: []string{"canary", "stable"}},
+			},
+		}, "app=web,track in (canary,stable)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dep := appsv1.Deployment{Spec: appsv1.DeploymentSpec{Selector: tt.selector}}
+			if got := deploymentSelector(dep); got != tt.want {
+				t.Errorf("deploymentSelector() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestartDeployment_AnnotatesTemplate(t *testing.T) {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"keep": "me"}}}},
+	}
+	c, cs := newFakeClient(dep)
+
+	if err := c.RestartDeployment(context.Background(), "web"); err != nil {
+		t.Fatalf("RestartDeployment() error = %v", err)
+	}
+	got, _ := cs.AppsV1().Deployments("default").Get(context.Background(), "web", metav1.GetOptions{})
+	annotations := got.Spec.Template.Annotations
+	if annotations[restartedAtAnnotation] == "" || annotations["keep"] != "me" {
+		t.Errorf("template annotations = %v, want restartedAt added", annotations)
+	}
+
+	if err := c.RestartDeployment(context.Background(), "missing"); err == nil {
+		t.Error("restarting a missing deployment should fail")
+	}
+}
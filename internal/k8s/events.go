@@ -6,12 +6,14 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
 func (c *Client) ListEvents(ctx context.Context) ([]domain.EventInfo, error) {
-	eventList, err := c.clientset.CoreV1().Events(c.namespace).List(ctx, metav1.ListOptions{
+	eventList, err := c.clientset.CoreV1().Events(c.listNamespace()).List(ctx, metav1.ListOptions{
 		Limit: 500,
 	})
 	if err != nil {
@@ -20,13 +22,117 @@ func (c *Client) ListEvents(ctx context.Context) ([]domain.EventInfo, error) {
 
 	events := make([]domain.EventInfo, 0, len(eventList.Items))
 	for _, evt := range eventList.Items {
-		events = append(events, eventToEventInfo(evt))
+		info := eventToEventInfo(evt)
+		info.Extra = extraFields(c.extraColumns.events, &evt, &evt)
+		events = append(events, info)
 	}
 	return events, nil
 }
 
+// ListObjectEvents lists the events whose involvedObject is ref, using a
+// field selector. For a deployment, the events of the ReplicaSets it owns and
+// of their current pods are added.
+func (c *Client) ListObjectEvents(ctx context.Context, ref domain.ObjectRef) ([]domain.EventInfo, error) {
+	events, err := c.listEventsOf(ctx, ref)
+	if err != nil || ref.Kind != "Deployment" {
+		return events, err
+	}
+
+	owned, err := c.deploymentObjects(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range owned {
+		objEvents, err := c.listEventsOf(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, objEvents...)
+	}
+	return events, nil
+}
+
+// listEventsOf lists the events of one object, page by page so that none
+// is left out in a busy namespace.
+func (c *Client) listEventsOf(ctx context.Context, ref domain.ObjectRef) ([]domain.EventInfo, error) {
+	set := fields.Set{"involvedObject.kind": ref.Kind, "involvedObject.name": ref.Name}
+	if ref.UID != "" {
+		set["involvedObject.uid"] = ref.UID
+	}
+	opts := metav1.ListOptions{FieldSelector: fields.SelectorFromSet(set).String(), Limit: 500}
+	var events []domain.EventInfo
+	for {
+		eventList, err := c.clientset.CoreV1().Events(c.namespace).List(ctx, opts)
+		if err != nil {
+			return nil, classifyError(err, c.serverURL)
+		}
+		for _, evt := range eventList.Items {
+			// Filter again: field selectors are not applied by every server
+			o := evt.InvolvedObject
+			if o.Kind == ref.Kind && o.Name == ref.Name && (ref.UID == "" || string(o.UID) == ref.UID) {
+				info := eventToEventInfo(evt)
+				info.Extra = extraFields(c.extraColumns.events, &evt, &evt)
+				events = append(events, info)
+			}
+		}
+		if eventList.Continue == "" {
+			return events, nil
+		}
+		opts.Continue = eventList.Continue
+	}
+}
+
+// deploymentObjects returns the ReplicaSets the deployment controls and the
+// pods they control, found by the deployment's selector and checked through
+// their ownerReferences.
+func (c *Client) deploymentObjects(ctx context.Context, ref domain.ObjectRef) ([]domain.ObjectRef, error) {
+	dep, err := c.clientset.AppsV1().Deployments(c.namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(dep.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("selector of deployment %s: %w", dep.Name, err)
+	}
+	opts := metav1.ListOptions{LabelSelector: selector.String()}
+
+	rsList, err := c.clientset.AppsV1().ReplicaSets(c.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+	var objects []domain.ObjectRef
+	replicaSets := make(map[types.UID]bool)
+	for _, rs := range rsList.Items {
+		if owner := metav1.GetControllerOf(&rs); owner != nil && owner.UID == dep.UID {
+			replicaSets[rs.UID] = true
+			objects = append(objects, domain.ObjectRef{Kind: "ReplicaSet", Name: rs.Name, UID: string(rs.UID)})
+		}
+	}
+	if len(replicaSets) == 0 {
+		return objects, nil
+	}
+
+	podList, err := c.clientset.CoreV1().Pods(c.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+	for _, pod := range podList.Items {
+		if owner := metav1.GetControllerOf(&pod); owner != nil && replicaSets[owner.UID] {
+			objects = append(objects, domain.ObjectRef{Kind: "Pod", Name: pod.Name, UID: string(pod.UID)})
+		}
+	}
+	return objects, nil
+}
+
+// WatchEvents watches the events from their current state on: existing
+// events are not sent again as ADDED when the watch opens.
 func (c *Client) WatchEvents(ctx context.Context) (<-chan domain.WatchEvent, error) {
-	watcher, err := c.clientset.CoreV1().Events(c.namespace).Watch(ctx, metav1.ListOptions{})
+	events := c.clientset.CoreV1().Events(c.listNamespace())
+	current, err := events.List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+	watcher, err := events.Watch(ctx, metav1.ListOptions{ResourceVersion: current.ResourceVersion})
 	if err != nil {
 		return nil, classifyError(err, c.serverURL)
 	}
@@ -47,6 +153,7 @@ func (c *Client) WatchEvents(ctx context.Context) (<-chan domain.WatchEvent, err
 					continue
 				}
 				info := eventToEventInfo(*evt)
+				info.Extra = extraFields(c.extraColumns.events, evt, evt)
 				wType := domain.WatchEventType(string(event.Type))
 				select {
 				case ch <- domain.WatchEvent{Type: wType, Resource: "event", Event: &info}:
@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	k8sTesting "k8s.io/client-go/testing"
 
@@ -133,3 +136,150 @@ func TestWatchEvents_ContextCancelClosesChannel(t *testing.T) {
 		t.Fatal("timed out waiting for channel close")
 	}
 }
+
+func TestListObjectEvents_Pod(t *testing.T) {
+	now := metav1.Now()
+	c, cs := newFakeClient(&corev1.EventList{Items: []corev1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "evt-1", Namespace: "default"},
+			Reason:         "BackOff",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-1", UID: "uid-1"},
+			LastTimestamp:  now,
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "evt-2", Namespace: "default"},
+			Reason:         "Killing",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-1", UID: "uid-old"},
+			LastTimestamp:  now,
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "evt-3", Namespace: "default"},
+			Reason:         "Scheduled",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-2"},
+			LastTimestamp:  now,
+		},
+	}})
+
+	result, err := c.ListObjectEvents(context.Background(), domain.ObjectRef{Kind: "Pod", Name: "web-1", UID: "uid-1"})
+	if err != nil {
+		t.Fatalf("ListObjectEvents() error = %v", err)
+	}
+	if len(result) != 1 || result[0].Reason != "BackOff" {
+		t.Errorf("result = %+v, want only the BackOff event of web-1", result)
+	}
+
+	list := cs.Actions()[0].(k8sTesting.ListAction)
+	want := "involvedObject.kind=Pod,involvedObject.name=web-1,involvedObject.uid=uid-1"
+	if got := list.GetListRestrictions().Fields.String(); got != want {
+		t.Errorf("field selector = %q, want %q", got, want)
+	}
+}
+
+func TestListObjectEvents_DeploymentIncludesReplicaSetsAndPods(t *testing.T) {
+	now := metav1.Now()
+	controller := true
+	labels := map[string]string{"app": "web"}
+	owned := func(owner string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Name: "owner", UID: types.UID(owner), Controller: &controller}}
+	}
+	event := func(name, kind, object, uid string) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Reason:         name,
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object, UID: types.UID(uid)},
+			LastTimestamp:  now,
+		}
+	}
+	c, cs := newFakeClient(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "dep-uid"},
+			Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: labels}},
+		},
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-7d4b9c", Namespace: "default", UID: "rs-uid", Labels: labels, OwnerReferences: owned("dep-uid"),
+		}},
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name: "api-5f6d7e", Namespace: "default", UID: "api-rs-uid", Labels: map[string]string{"app": "api"}, OwnerReferences: owned("api-uid"),
+		}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-7d4b9c-x2k8p", Namespace: "default", UID: "pod-uid", Labels: labels, OwnerReferences: owned("rs-uid"),
+		}},
+		// Same name prefix and labels, but not owned by the ReplicaSet
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-7d4b9c-manual", Namespace: "default", UID: "manual-uid", Labels: labels,
+		}},
+		&corev1.EventList{Items: []corev1.Event{
+			event("ScalingReplicaSet", "Deployment", "web", "dep-uid"),
+			event("SuccessfulCreate", "ReplicaSet", "web-7d4b9c", "rs-uid"),
+			event("Pulled", "Pod", "web-7d4b9c-x2k8p", "pod-uid"),
+			event("ManualPod", "Pod", "web-7d4b9c-manual", "manual-uid"),
+			event("OtherRS", "ReplicaSet", "api-5f6d7e", "api-rs-uid"),
+		}},
+	)
+
+	result, err := c.ListObjectEvents(context.Background(), domain.ObjectRef{Kind: "Deployment", Name: "web", UID: "dep-uid"})
+	if err != nil {
+		t.Fatalf("ListObjectEvents() error = %v", err)
+	}
+	var reasons []string
+	for _, e := range result {
+		reasons = append(reasons, e.Reason)
+	}
+	if len(reasons) != 3 || reasons[0] != "ScalingReplicaSet" || reasons[1] != "SuccessfulCreate" || reasons[2] != "Pulled" {
+		t.Errorf("reasons = %v, want [ScalingReplicaSet SuccessfulCreate Pulled]", reasons)
+	}
+
+	// Each object is queried by name, not the whole namespace by kind
+	var selectors []string
+	for _, a := range cs.Actions() {
+		if list, ok := a.(k8sTesting.ListAction); ok && a.GetResource().Resource == "events" {
+			selectors = append(selectors, list.GetListRestrictions().Fields.String())
+		}
+	}
+	want := "involvedObject.kind=Pod,involvedObject.name=web-7d4b9c-x2k8p,involvedObject.uid=pod-uid"
+	if len(selectors) != 3 || selectors[2] != want {
+		t.Errorf("event field selectors = %v, want the last one %q", selectors, want)
+	}
+}
+
+func TestListObjectEvents_Paginates(t *testing.T) {
+	c, cs := newFakeClient()
+	cs.PrependReactor("list", "events", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		page := &corev1.EventList{}
+		ref := corev1.ObjectReference{Kind: "Pod", Name: "web-1"}
+		if action.(k8sTesting.ListActionImpl).GetListOptions().Continue == "" {
+			page.Continue = "page-2"
+			page.Items = []corev1.Event{{Reason: "Scheduled", InvolvedObject: ref}}
+		} else {
+			page.Items = []corev1.Event{{Reason: "BackOff", InvolvedObject: ref}}
+		}
+		return true, page, nil
+	})
+
+	result, err := c.ListObjectEvents(context.Background(), domain.ObjectRef{Kind: "Pod", Name: "web-1"})
+	if err != nil {
+		t.Fatalf("ListObjectEvents() error = %v", err)
+	}
+	if len(result) != 2 || result[1].Reason != "BackOff" {
+		t.Errorf("result = %+v, want the events of both pages", result)
+	}
+}
+
+func TestWatchEvents_StartsFromCurrentVersion(t *testing.T) {
+	c, cs := newFakeClient()
+	cs.PrependReactor("list", "events", func(k8sTesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.EventList{ListMeta: metav1.ListMeta{ResourceVersion: "42"}}, nil
+	})
+	cs.PrependWatchReactor("events", k8sTesting.DefaultWatchReactor(watch.NewFake(), nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := c.WatchEvents(ctx); err != nil {
+		t.Fatalf("WatchEvents() error = %v", err)
+	}
+
+	w := cs.Actions()[1].(k8sTesting.WatchActionImpl)
+	if got := w.GetWatchRestrictions().ResourceVersion; got != "42" {
+		t.Errorf("watch resourceVersion = %q, want the listed one", got)
+	}
+}
@@ -1,13 +1,48 @@
 package k8s
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
+	"os"
 	"os/exec"
+	"os/signal"
+	"path"
+
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
 // LookPathFunc allows overriding exec.LookPath for testing.
 var LookPathFunc = exec.LookPath
 
+// NewExecutorFunc creates the remotecommand executor used by the built-in exec.
+// It tries WebSocket first and falls back to SPDY, like kubectl does.
+// Tests replace it with a fake executor to avoid a real API server.
+var NewExecutorFunc = func(config *rest.Config, u *url.URL) (remotecommand.Executor, error) {
+	spdyExec, err := remotecommand.NewSPDYExecutor(config, "POST", u)
+	if err != nil {
+		return nil, err
+	}
+	wsExec, err := remotecommand.NewWebSocketExecutor(config, "GET", u.String())
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewFallbackExecutor(wsExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+}
+
 // BuildExecCmd builds an exec.Cmd to shell into a pod using oc or kubectl.
 func (c *Client) BuildExecCmd(namespace, podName, containerName, shell string) (*exec.Cmd, error) {
 	tool, err := findExecTool()
@@ -25,6 +60,63 @@ func (c *Client) BuildExecCmd(namespace, podName, containerName, shell string) (
 	return cmd, nil
 }
 
+// NewExecSession builds an in-process exec session over client-go remotecommand.
+// It needs neither oc nor kubectl (spec section 6.3, approach 2).
+func (c *Client) NewExecSession(namespace, podName, containerName string, command []string) (domain.ExecSession, error) {
+	if c.config == nil {
+		return nil, fmt.Errorf("exec intégré indisponible : configuration client absente")
+	}
+	u, err := execURL(c.config.Host, namespace, podName, &corev1.PodExecOptions{
+		Container: containerName,
+		Command:   command,
+		Stdin:     true,
+		Stdout:    true,
+		TTY:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &remoteExecSession{config: c.config, url: u, serverURL: c.serverURL}, nil
+}
+
+// RunCommand executes a non-interactive command in a container over
+// remotecommand and captures stdout and stderr separately.
+func (c *Client) RunCommand(ctx context.Context, namespace, podName, containerName string, command []string) (domain.CommandResult, error) {
+	if c.config == nil {
+		return domain.CommandResult{}, fmt.Errorf("exec intégré indisponible : configuration client absente")
+	}
+	u, err := execURL(c.config.Host, namespace, podName, &corev1.PodExecOptions{
+		Container: containerName,
+		Command:   command,
+		Stdout:    true,
+		Stderr:    true,
+	})
+	if err != nil {
+		return domain.CommandResult{}, err
+	}
+	executor, err := NewExecutorFunc(c.config, u)
+	if err != nil {
+		return domain.CommandResult{}, classifyError(err, c.serverURL)
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	result := domain.CommandResult{Stdout: stdout.String(), Stderr: stderr.String()}
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		result.ExitCode = exitErr.ExitStatus()
+		return result, nil
+	}
+	if err != nil {
+		return result, classifyError(err, c.serverURL)
+	}
+	return result, nil
+}
+
 func findExecTool() (string, error) {
 	if path, err := LookPathFunc("oc"); err == nil {
 		return path, nil
@@ -32,5 +124,111 @@ func findExecTool() (string, error) {
 	if path, err := LookPathFunc("kubectl"); err == nil {
 		return path, nil
 	}
-	return "", fmt.Errorf("ni 'oc' ni 'kubectl' trouvé dans le PATH")
+	return "", domain.ErrNoExecTool
+}
+
+// execURL builds the pods/exec subresource URL. It is assembled by hand rather
+// than through the clientset's RESTClient so that it works with the fake clientset.
+func execURL(host, namespace, podName string, opts *corev1.PodExecOptions) (*url.URL, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("URL du serveur invalide : %w", err)
+	}
+	u.Path = path.Join(u.Path, "/api/v1/namespaces", namespace, "pods", podName, "exec")
+	params, err := scheme.ParameterCodec.EncodeParameters(opts, corev1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = params.Encode()
+	return u, nil
+}
+
+// remoteExecSession implements domain.ExecSession on top of remotecommand.
+type remoteExecSession struct {
+	config    *rest.Config
+	url       *url.URL
+	serverURL string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+}
+
+func (s *remoteExecSession) SetStdin(r io.Reader)  { s.stdin = r }
+func (s *remoteExecSession) SetStdout(w io.Writer) { s.stdout = w }
+func (s *remoteExecSession) SetStderr(w io.Writer) { s.stderr = w }
+
+// Run streams the session. When stdin is a terminal it is switched to raw mode
+// for the duration of the session and restored on return; SIGWINCH is forwarded
+// as resize events.
+func (s *remoteExecSession) Run() error {
+	executor, err := NewExecutorFunc(s.config, s.url)
+	if err != nil {
+		return classifyError(err, s.serverURL)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Stderr stays nil: with a TTY the remote side merges it into stdout.
+	opts := remotecommand.StreamOptions{
+		Stdin:  s.stdin,
+		Stdout: s.stdout,
+		Tty:    true,
+	}
+
+	if f, ok := s.stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fd := int(f.Fd())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return fmt.Errorf("impossible de passer le terminal en mode raw : %w", err)
+		}
+		defer func() { _ = term.Restore(fd, state) }()
+		opts.TerminalSizeQueue = newTerminalSizeQueue(ctx, fd)
+	}
+
+	if err := executor.StreamWithContext(ctx, opts); err != nil {
+		return classifyError(err, s.serverURL)
+	}
+	return nil
+}
+
+// terminalSizeQueue feeds terminal resizes to remotecommand.
+type terminalSizeQueue struct {
+	ch chan remotecommand.TerminalSize
+}
+
+// newTerminalSizeQueue reports the current size of fd, then a new size on every
+// SIGWINCH until ctx is done.
+func newTerminalSizeQueue(ctx context.Context, fd int) *terminalSizeQueue {
+	q := &terminalSizeQueue{ch: make(chan remotecommand.TerminalSize, 1)}
+	sigCh := make(chan os.Signal, 1)
+	notifyResize(sigCh)
+
+	go func() {
+		defer signal.Stop(sigCh)
+		defer close(q.ch)
+		for {
+			if w, h, err := term.GetSize(fd); err == nil {
+				select {
+				case q.ch <- remotecommand.TerminalSize{Width: uint16(w), Height: uint16(h)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-sigCh:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return q
+}
+
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q.ch
+	if !ok {
+		return nil
+	}
+	return &size
 }
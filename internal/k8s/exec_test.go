@@ -1,9 +1,20 @@
 package k8s
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"strings"
 	"testing"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
 func TestBuildExecCmd_WithOc(t *testing.T) {
@@ -100,3 +111,201 @@ func TestBuildExecCmd_PrefersOcOverKubectl(t *testing.T) {
 		t.Errorf("Path = %q, want /usr/bin/oc (should prefer oc)", cmd.Path)
 	}
 }
+
+func TestBuildExecCmd_NoToolIsErrNoExecTool(t *testing.T) {
+	original := LookPathFunc
+	defer func() { LookPathFunc = original }()
+
+	LookPathFunc = func(string) (string, error) {
+		return "", fmt.Errorf("not found")
+	}
+
+	c := &Client{namespace: "default"}
+	_, err := c.BuildExecCmd("myns", "web-1", "", "/bin/sh")
+	if !errors.Is(err, domain.ErrNoExecTool) {
+		t.Errorf("err = %v, want domain.ErrNoExecTool", err)
+	}
+}
+
+// fakeExecutor records the stream options and stdin, and writes canned output.
+type fakeExecutor struct {
+	opts   remotecommand.StreamOptions
+	output string
+	stderr string
+	stdin  []byte
+	err    error
+}
+
+func (f *fakeExecutor) Stream(opts remotecommand.StreamOptions) error {
+	return f.StreamWithContext(context.Background(), opts)
+}
+
+func (f *fakeExecutor) StreamWithContext(_ context.Context, opts remotecommand.StreamOptions) error {
+	f.opts = opts
+	if opts.Stdin != nil {
+		f.stdin, _ = io.ReadAll(opts.Stdin)
+	}
+	if opts.Stdout != nil {
+		_, _ = io.WriteString(opts.Stdout, f.output)
+	}
+	if opts.Stderr != nil {
+		_, _ = io.WriteString(opts.Stderr, f.stderr)
+	}
+	return f.err
+}
+
+func withFakeExecutor(t *testing.T, fake *fakeExecutor) *url.URL {
+	t.Helper()
+	original := NewExecutorFunc
+	t.Cleanup(func() { NewExecutorFunc = original })
+
+	gotURL := &url.URL{}
+	NewExecutorFunc = func(_ *rest.Config, u *url.URL) (remotecommand.Executor, error) {
+		*gotURL = *u
+		return fake, nil
+	}
+	return gotURL
+}
+
+func TestNewExecSession_BuildsExecURL(t *testing.T) {
+	fake := &fakeExecutor{}
+	gotURL := withFakeExecutor(t, fake)
+
+	c := &Client{namespace: "default", config: &rest.Config{Host: "https://fake:6443"}}
+	session, err := c.NewExecSession("myns", "web-1", "sidecar", []string{"/bin/sh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.SetStdin(strings.NewReader(""))
+	session.SetStdout(&bytes.Buffer{})
+	if err := session.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if gotURL.Path != "/api/v1/namespaces/myns/pods/web-1/exec" {
+		t.Errorf("Path = %q", gotURL.Path)
+	}
+	q := gotURL.Query()
+	if q.Get("container") != "sidecar" {
+		t.Errorf("container = %q, want sidecar", q.Get("container"))
+	}
+	if q.Get("command") != "/bin/sh" {
+		t.Errorf("command = %q, want /bin/sh", q.Get("command"))
+	}
+	if q.Get("tty") != "true" || q.Get("stdin") != "true" {
+		t.Errorf("query = %q, want tty and stdin", gotURL.RawQuery)
+	}
+}
+
+func TestExecSession_StreamsThroughExecutor(t *testing.T) {
+	fake := &fakeExecutor{output: "hello from pod"}
+	withFakeExecutor(t, fake)
+
+	c := &Client{namespace: "default", config: &rest.Config{Host: "https://fake:6443"}}
+	session, err := c.NewExecSession("myns", "web-1", "", []string{"/bin/sh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	session.SetStdin(strings.NewReader("exit\n"))
+	session.SetStdout(&out)
+	session.SetStderr(&out)
+	if err := session.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if out.String() != "hello from pod" {
+		t.Errorf("stdout = %q, want %q", out.String(), "hello from pod")
+	}
+	if !fake.opts.Tty {
+		t.Error("Tty should be true")
+	}
+	if fake.opts.Stderr != nil {
+		t.Error("Stderr should be nil with a TTY")
+	}
+	// A non-terminal stdin must not get a resize queue.
+	if fake.opts.TerminalSizeQueue != nil {
+		t.Error("TerminalSizeQueue should be nil when stdin is not a terminal")
+	}
+}
+
+func TestExecSession_ExecutorErrorIsClassified(t *testing.T) {
+	fake := &fakeExecutor{err: fmt.Errorf("dial tcp: connection refused")}
+	withFakeExecutor(t, fake)
+
+	c := &Client{config: &rest.Config{Host: "https://fake:6443"}, serverURL: "https://fake:6443"}
+	session, err := c.NewExecSession("myns", "web-1", "", []string{"/bin/sh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = session.Run()
+
+	var apiErr *domain.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want APIError", err)
+	}
+	if apiErr.Type != domain.ErrUnreachable {
+		t.Errorf("Type = %v, want ErrUnreachable", apiErr.Type)
+	}
+}
+
+func TestNewExecSession_NoConfig(t *testing.T) {
+	c := &Client{namespace: "default"}
+	if _, err := c.NewExecSession("myns", "web-1", "", []string{"/bin/sh"}); err == nil {
+		t.Fatal("expected error without rest config")
+	}
+}
+
+func TestRunCommand_CapturesOutput(t *testing.T) {
+	fake := &fakeExecutor{output: "PATH=/usr/bin\n"}
+	gotURL := withFakeExecutor(t, fake)
+
+	c := &Client{config: &rest.Config{Host: "https://fake:6443"}}
+	res, err := c.RunCommand(context.Background(), "myns", "web-1", "app", []string{"env"})
+	if err != nil {
+		t.Fatalf("RunCommand() error = %v", err)
+	}
+	if res.Stdout != "PATH=/usr/bin\n" {
+		t.Errorf("Stdout = %q", res.Stdout)
+	}
+	if res.ExitCode != 0 {
+		t.Errorf("ExitCode = %d, want 0", res.ExitCode)
+	}
+	if fake.opts.Tty || fake.opts.Stdin != nil {
+		t.Error("RunCommand should be non-interactive (no TTY, no stdin)")
+	}
+	q := gotURL.Query()
+	if q.Get("tty") == "true" || q.Get("stdin") == "true" {
+		t.Errorf("query = %q, want neither tty nor stdin", gotURL.RawQuery)
+	}
+	if q.Get("stderr") != "true" {
+		t.Errorf("query = %q, want stderr", gotURL.RawQuery)
+	}
+}
+
+func TestRunCommand_NonZeroExitIsNotAnError(t *testing.T) {
+	fake := &fakeExecutor{err: utilexec.CodeExitError{Err: fmt.Errorf("command terminated with exit code 2"), Code: 2}}
+	withFakeExecutor(t, fake)
+
+	c := &Client{config: &rest.Config{Host: "https://fake:6443"}}
+	res, err := c.RunCommand(context.Background(), "myns", "web-1", "", []string{"cat", "/missing"})
+	if err != nil {
+		t.Fatalf("RunCommand() error = %v, want nil for non-zero exit", err)
+	}
+	if res.ExitCode != 2 {
+		t.Errorf("ExitCode = %d, want 2", res.ExitCode)
+	}
+}
+
+func TestRunCommand_StreamErrorIsClassified(t *testing.T) {
+	fake := &fakeExecutor{err: fmt.Errorf("dial tcp: i/o timeout")}
+	withFakeExecutor(t, fake)
+
+	c := &Client{config: &rest.Config{Host: "https://fake:6443"}}
+	_, err := c.RunCommand(context.Background(), "myns", "web-1", "", []string{"env"})
+
+	var apiErr *domain.APIError
+	if !errors.As(err, &apiErr) || apiErr.Type != domain.ErrUnreachable {
+		t.Errorf("err = %v, want ErrUnreachable APIError", err)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package k8s
+
+import "os"
+
+// notifyResize is a no-op on Windows, which has no SIGWINCH: the initial
+// terminal size is still sent when the session starts.
+func notifyResize(chan<- os.Signal) {}
@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+const nodeRolePrefix = "node-role.kubernetes.io/"
+
+func (c *Client) ListNodes(ctx context.Context) ([]domain.NodeInfo, error) {
+	nodeList, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		Limit: 500,
+	})
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+
+	nodes := make([]domain.NodeInfo, 0, len(nodeList.Items))
+	for i := range nodeList.Items {
+		nodes = append(nodes, nodeToNodeInfo(&nodeList.Items[i]))
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	return nodes, nil
+}
+
+func nodeToNodeInfo(node *corev1.Node) domain.NodeInfo {
+	info := domain.NodeInfo{
+		Name:   node.Name,
+		Status: "NotReady",
+		Age:    formatAge(node.CreationTimestamp.Time),
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type != corev1.NodeReady {
+			continue
+		}
+		if cond.Status == corev1.ConditionTrue {
+			info.Ready = true
+			info.Status = "Ready"
+		} else {
+			info.Reason = cond.Message
+		}
+	}
+	if node.Spec.Unschedulable {
+		info.Status += ",SchedulingDisabled"
+	}
+
+	var roles []string
+	for label := range node.Labels {
+		if role, ok := strings.CutPrefix(label, nodeRolePrefix); ok && role != "" {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	info.Roles = strings.Join(roles, ",")
+	return info
+}
@@ -0,0 +1,45 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListNodes(t *testing.T) {
+	ready := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Labels: map[string]string{
+			"node-role.kubernetes.io/worker": "",
+			"node-role.kubernetes.io/infra":  "",
+		}},
+		Spec: corev1.NodeSpec{Unschedulable: true},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		}},
+	}
+	notReady := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "master-0"},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Message: "Kubelet stopped posting node status."},
+		}},
+	}
+	c, _ := newFakeClient(ready, notReady)
+
+	nodes, err := c.ListNodes(context.Background())
+	if err != nil {
+		t.Fatalf("ListNodes: %v", err)
+	}
+	if len(nodes) != 2 || nodes[0].Name != "master-0" {
+		t.Fatalf("nodes = %+v, want master-0 then worker-1", nodes)
+	}
+	if nodes[0].Ready || nodes[0].Status != "NotReady" || nodes[0].Reason != "Kubelet stopped posting node status." {
+		t.Errorf("master-0 = %+v, want NotReady with the condition message", nodes[0])
+	}
+	w := nodes[1]
+	if !w.Ready || w.Status != "Ready,SchedulingDisabled" || w.Roles != "infra,worker" {
+		t.Errorf("worker-1 = %+v, want cordoned Ready node with roles infra,worker", w)
+	}
+}
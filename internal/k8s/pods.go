@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"time"
@@ -11,8 +12,11 @@ import (
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
+// maxLogLineSize is the longest log line StreamPodLogs accepts before giving up on the stream.
+const maxLogLineSize = 1024 * 1024
+
 func (c *Client) ListPods(ctx context.Context) ([]domain.PodInfo, error) {
-	podList, err := c.clientset.CoreV1().Pods(c.namespace).List(ctx, metav1.ListOptions{
+	podList, err := c.clientset.CoreV1().Pods(c.listNamespace()).List(ctx, metav1.ListOptions{
 		Limit: 500,
 	})
 	if err != nil {
@@ -21,13 +25,15 @@ func (c *Client) ListPods(ctx context.Context) ([]domain.PodInfo, error) {
 
 	pods := make([]domain.PodInfo, 0, len(podList.Items))
 	for _, pod := range podList.Items {
-		pods = append(pods, podToPodInfo(pod))
+		info := podToPodInfo(pod)
+		info.Extra = extraFields(c.extraColumns.pods, &pod, &pod)
+		pods = append(pods, info)
 	}
 	return pods, nil
 }
 
 func (c *Client) WatchPods(ctx context.Context) (<-chan domain.WatchEvent, error) {
-	watcher, err := c.clientset.CoreV1().Pods(c.namespace).Watch(ctx, metav1.ListOptions{})
+	watcher, err := c.clientset.CoreV1().Pods(c.listNamespace()).Watch(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, classifyError(err, c.serverURL)
 	}
@@ -48,6 +54,7 @@ func (c *Client) WatchPods(ctx context.Context) (<-chan domain.WatchEvent, error
 					continue
 				}
 				info := podToPodInfo(*pod)
+				info.Extra = extraFields(c.extraColumns.pods, pod, pod)
 				wType := domain.WatchEventType(string(event.Type))
 				select {
 				case ch <- domain.WatchEvent{Type: wType, Resource: "pod", Pod: &info}:
@@ -60,21 +67,66 @@ func (c *Client) WatchPods(ctx context.Context) (<-chan domain.WatchEvent, error
 	return ch, nil
 }
 
-func (c *Client) GetPodLogs(ctx context.Context, podName, containerName string, tailLines int64, previous bool) (string, error) {
-	opts := &corev1.PodLogOptions{
-		TailLines: &tailLines,
-		Previous:  previous,
-	}
-	if containerName != "" {
-		opts.Container = containerName
-	}
-	result, err := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, opts).Do(ctx).Raw()
+func (c *Client) GetPodLogs(ctx context.Context, podName, containerName string, opts domain.LogOptions) (string, error) {
+	result, err := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, podLogOptions(containerName, opts)).Do(ctx).Raw()
 	if err != nil {
 		return "", classifyError(err, c.serverURL)
 	}
 	return string(result), nil
 }
 
+// StreamPodLogs follows a container log with Follow=true and emits it line by line.
+// Server timestamps are requested so that lines from several pods can be interleaved.
+func (c *Client) StreamPodLogs(ctx context.Context, podName, containerName string, opts domain.LogOptions) (<-chan domain.LogLine, error) {
+	opts.Timestamps = true
+	opts.Previous = false
+	logOpts := podLogOptions(containerName, opts)
+	logOpts.Follow = true
+	stream, err := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, logOpts).Stream(ctx)
+	if err != nil {
+		return nil, classifyError(err, c.serverURL)
+	}
+	ch := make(chan domain.LogLine, 256)
+	go func() {
+		defer close(ch)
+		defer stream.Close()
+		scanner := bufio.NewScanner(stream)
+		scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+		for scanner.Scan() {
+			ts, text := domain.SplitLogTimestamp(scanner.Text())
+			select {
+			case ch <- domain.LogLine{PodName: podName, ContainerName: containerName, Timestamp: ts, Text: text}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// podLogOptions maps the domain log options onto the API ones, leaving the
+// unset limits out of the request.
+func podLogOptions(containerName string, opts domain.LogOptions) *corev1.PodLogOptions {
+	logOpts := &corev1.PodLogOptions{
+		Container:  containerName,
+		Timestamps: opts.Timestamps,
+		Previous:   opts.Previous,
+	}
+	if opts.TailLines >= 0 {
+		logOpts.TailLines = &opts.TailLines
+	}
+	if opts.SinceSeconds > 0 {
+		logOpts.SinceSeconds = &opts.SinceSeconds
+	} else if !opts.SinceTime.IsZero() {
+		since := metav1.NewTime(opts.SinceTime)
+		logOpts.SinceTime = &since
+	}
+	if opts.LimitBytes > 0 {
+		logOpts.LimitBytes = &opts.LimitBytes
+	}
+	return logOpts
+}
+
 func (c *Client) DeletePod(ctx context.Context, podName string) error {
 	err := c.clientset.CoreV1().Pods(c.namespace).Delete(ctx, podName, metav1.DeleteOptions{})
 	return classifyError(err, c.serverURL)
@@ -88,30 +140,47 @@ func podToPodInfo(pod corev1.Pod) domain.PodInfo {
 		restarts += cs.RestartCount
 	}
 
-	// Build container info list
+	// Build container info list: init, app, then ephemeral containers
 	statusMap := make(map[string]corev1.ContainerStatus)
-	for _, cs := range pod.Status.ContainerStatuses {
-		statusMap[cs.Name] = cs
+	for _, statuses := range [][]corev1.ContainerStatus{
+		pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses, pod.Status.EphemeralContainerStatuses,
+	} {
+		for _, cs := range statuses {
+			statusMap[cs.Name] = cs
+		}
 	}
-	containers := make([]domain.ContainerInfo, 0, len(pod.Spec.Containers))
-	for _, c := range pod.Spec.Containers {
-		ci := domain.ContainerInfo{Name: c.Name}
-		if cs, ok := statusMap[c.Name]; ok {
+	containers := make([]domain.ContainerInfo, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers)+len(pod.Spec.EphemeralContainers))
+	addContainer := func(name, image, kind string) {
+		ci := domain.ContainerInfo{Name: name, Image: image, Kind: kind}
+		if cs, ok := statusMap[name]; ok {
 			ci.Ready = cs.Ready
 			ci.State = containerState(cs)
+			ci.Reason = containerReason(cs)
 		}
 		containers = append(containers, ci)
 	}
+	for _, c := range pod.Spec.InitContainers {
+		addContainer(c.Name, c.Image, domain.ContainerKindInit)
+	}
+	for _, c := range pod.Spec.Containers {
+		addContainer(c.Name, c.Image, domain.ContainerKindApp)
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		addContainer(c.Name, c.Image, domain.ContainerKindEphemeral)
+	}
 
 	return domain.PodInfo{
 		Name:       pod.Name,
+		UID:        string(pod.UID),
 		Namespace:  pod.Namespace,
+		Phase:      string(pod.Status.Phase),
 		Status:     status,
 		Ready:      fmt.Sprintf("%d/%d", ready, total),
 		Restarts:   restarts,
 		Age:        formatAge(pod.CreationTimestamp.Time),
 		Node:       pod.Spec.NodeName,
 		Containers: containers,
+		Labels:     pod.Labels,
 		CreatedAt:  pod.CreationTimestamp.Time,
 	}
 }
@@ -129,7 +198,28 @@ func containerState(cs corev1.ContainerStatus) string {
 	}
 }
 
+func containerReason(cs corev1.ContainerStatus) string {
+	switch {
+	case cs.State.Waiting != nil:
+		return cs.State.Waiting.Reason
+	case cs.State.Terminated != nil:
+		return cs.State.Terminated.Reason
+	default:
+		return ""
+	}
+}
+
 func podStatus(pod corev1.Pod) string {
+	// Init containers first, like kubectl: while they run, the app
+	// containers only wait with PodInitializing
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" && cs.State.Waiting.Reason != "PodInitializing" {
+			return "Init:" + cs.State.Waiting.Reason
+		}
+		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0 {
+			return "Init:Error"
+		}
+	}
 	// Check container statuses for more specific states
 	for _, cs := range pod.Status.ContainerStatuses {
 		if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" {
@@ -139,15 +229,6 @@ func podStatus(pod corev1.Pod) string {
 			return cs.State.Terminated.Reason
 		}
 	}
-	// Check init container statuses
-	for _, cs := range pod.Status.InitContainerStatuses {
-		if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" {
-			return "Init:" + cs.State.Waiting.Reason
-		}
-		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0 {
-			return "Init:Error"
-		}
-	}
 	return string(pod.Status.Phase)
 }
 
@@ -1,11 +1,16 @@
 package k8s
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeK8s "k8s.io/client-go/kubernetes/fake"
+	k8sTesting "k8s.io/client-go/testing"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
 func TestFormatAge(t *testing.T) {
@@ -87,6 +92,21 @@ func TestPodStatus(t *testing.T) {
 			},
 			"Init:Error",
 		},
+		{
+			"init container crashloop",
+			corev1.Pod{
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					InitContainerStatuses: []corev1.ContainerStatus{
+						{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+					},
+					ContainerStatuses: []corev1.ContainerStatus{
+						{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}}},
+					},
+				},
+			},
+			"Init:CrashLoopBackOff",
+		},
 		{
 			"completed pod",
 			corev1.Pod{
@@ -192,7 +212,7 @@ func TestPodToPodInfo(t *testing.T) {
 		},
 		Spec: corev1.PodSpec{
 			NodeName:   "node-1",
-			Containers: []corev1.Container{{Name: "main"}},
+			Containers: []corev1.Container{{Name: "main", Image: "registry.example.com/app:1.2"}},
 		},
 		Status: corev1.PodStatus{
 			Phase: corev1.PodRunning,
@@ -226,7 +246,206 @@ func TestPodToPodInfo(t *testing.T) {
 	if info.Node != "node-1" {
 		t.Errorf("Node = %q, want %q", info.Node, "node-1")
 	}
+	if len(info.Containers) != 1 || info.Containers[0].Image != "registry.example.com/app:1.2" {
+		t.Errorf("Containers = %+v, want the image of main", info.Containers)
+	}
 	if info.Age != "2h" {
 		t.Errorf("Age = %q, want %q", info.Age, "2h")
 	}
 }
+
+func TestPodToPodInfo_InitAndEphemeralContainers(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "wait-db"}, {Name: "migrate"}},
+			Containers:     []corev1.Container{{Name: "app"}},
+			EphemeralContainers: []corev1.EphemeralContainer{{
+				EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-x1"},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "wait-db", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}},
+				{Name: "migrate", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}}},
+			},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{
+				{Name: "debugger-x1", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+
+	info := podToPodInfo(pod)
+
+	want := []domain.ContainerInfo{
+		{Name: "wait-db", Kind: domain.ContainerKindInit, State: "terminated", Reason: "Completed"},
+		{Name: "migrate", Kind: domain.ContainerKindInit, State: "waiting", Reason: "CrashLoopBackOff"},
+		{Name: "app", Kind: domain.ContainerKindApp, State: "waiting", Reason: "PodInitializing"},
+		{Name: "debugger-x1", Kind: domain.ContainerKindEphemeral, State: "running"},
+	}
+	if len(info.Containers) != len(want) {
+		t.Fatalf("Containers = %+v", info.Containers)
+	}
+	for i, c := range want {
+		if info.Containers[i] != c {
+			t.Errorf("Containers[%d] = %+v, want %+v", i, info.Containers[i], c)
+		}
+	}
+	if info.Ready != "0/1" {
+		t.Errorf("Ready = %q, want only app containers counted", info.Ready)
+	}
+}
+
+func TestStreamPodLogs_FollowsAndCloses(t *testing.T) {
+	c, cs := newFakeClient()
+
+	ch, err := c.StreamPodLogs(context.Background(), "web-1", "app", domain.LogOptions{TailLines: 50, Previous: true})
+	if err != nil {
+		t.Fatalf("StreamPodLogs() error = %v", err)
+	}
+
+	var lines []string
+	for line := range ch {
+		if line.PodName != "web-1" || line.ContainerName != "app" {
+			t.Errorf("line = %+v, want web-1/app", line)
+		}
+		lines = append(lines, line.Text)
+	}
+	if len(lines) != 1 || lines[0] != "fake logs" {
+		t.Errorf("lines = %q, want [fake logs]", lines)
+	}
+
+	opts := lastLogOptions(t, cs)
+	if !opts.Follow || opts.Container != "app" || opts.TailLines == nil || *opts.TailLines != 50 {
+		t.Errorf("opts = %+v, want follow on app with tail 50", opts)
+	}
+	if !opts.Timestamps || opts.Previous {
+		t.Errorf("opts = %+v, want timestamps and never previous", opts)
+	}
+}
+
+func lastLogOptions(t *testing.T, cs *fakeK8s.Clientset) *corev1.PodLogOptions {
+	t.Helper()
+	var opts *corev1.PodLogOptions
+	for _, a := range cs.Actions() {
+		if a.GetSubresource() == "log" {
+			opts = a.(k8sTesting.GenericAction).GetValue().(*corev1.PodLogOptions)
+		}
+	}
+	if opts == nil {
+		t.Fatal("expected a log request")
+	}
+	return opts
+}
+
+func TestGetPodLogs_Options(t *testing.T) {
+	since := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		opts  domain.LogOptions
+		check func(*corev1.PodLogOptions) bool
+	}{
+		{"tail", domain.LogOptions{TailLines: 200}, func(o *corev1.PodLogOptions) bool {
+			return o.TailLines != nil && *o.TailLines == 200 && o.SinceSeconds == nil && o.LimitBytes == nil
+		}},
+		{"all lines", domain.LogOptions{TailLines: -1, LimitBytes: 1024}, func(o *corev1.PodLogOptions) bool {
+			return o.TailLines == nil && o.LimitBytes != nil && *o.LimitBytes == 1024
+		}},
+		{"since seconds", domain.LogOptions{TailLines: -1, SinceSeconds: 900, Timestamps: true}, func(o *corev1.PodLogOptions) bool {
+			return o.SinceSeconds != nil && *o.SinceSeconds == 900 && o.SinceTime == nil && o.Timestamps
+		}},
+		{"since time", domain.LogOptions{TailLines: -1, SinceTime: since, Previous: true}, func(o *corev1.PodLogOptions) bool {
+			return o.SinceTime != nil && o.SinceTime.Time.Equal(since) && o.Previous
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, cs := newFakeClient()
+			if _, err := c.GetPodLogs(context.Background(), "web-1", "app", tt.opts); err != nil {
+				t.Fatalf("GetPodLogs() error = %v", err)
+			}
+			if opts := lastLogOptions(t, cs); !tt.check(opts) || opts.Container != "app" {
+				t.Errorf("opts = %+v", opts)
+			}
+		})
+	}
+}
+
+func TestAllNamespaces_ListsEveryNamespaceAndRoutesActions(t *testing.T) {
+	c, cs := newFakeClient(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "api-1", Namespace: "team-b"}},
+	)
+	ctx := context.Background()
+
+	pods, err := c.ListPods(ctx)
+	if err != nil || len(pods) != 1 {
+		t.Fatalf("ListPods() = %d pods, %v; want 1 in default", len(pods), err)
+	}
+
+	c.SetAllNamespaces(true)
+	pods, err = c.ListPods(ctx)
+	if err != nil || len(pods) != 2 {
+		t.Fatalf("ListPods() = %d pods, %v; want 2 across namespaces", len(pods), err)
+	}
+	if c.GetNamespace() != "default" {
+		t.Errorf("GetNamespace() = %q, want default unchanged", c.GetNamespace())
+	}
+
+	scoped := c.InNamespace("team-b")
+	if scoped.AllNamespaces() || scoped.GetNamespace() != "team-b" {
+		t.Errorf("scoped client: all = %v, ns = %q", scoped.AllNamespaces(), scoped.GetNamespace())
+	}
+	if err := scoped.DeletePod(ctx, "api-1"); err != nil {
+		t.Fatalf("DeletePod() error = %v", err)
+	}
+	if _, err := cs.CoreV1().Pods("team-b").Get(ctx, "api-1", metav1.GetOptions{}); err == nil {
+		t.Error("api-1 should be deleted from team-b")
+	}
+	if !c.AllNamespaces() {
+		t.Error("InNamespace should not change the parent client")
+	}
+}
+
+func TestListPods_FailingInitContainer(t *testing.T) {
+	waiting := func(reason string) corev1.ContainerState {
+		return corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "setup"}, {Name: "migrate"}},
+			Containers:     []corev1.Container{{Name: "app"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "setup", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}},
+				{Name: "migrate", State: waiting("CrashLoopBackOff")},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app", State: waiting("PodInitializing")}},
+		},
+	}
+	c, _ := newFakeClient(pod)
+
+	pods, err := c.ListPods(context.Background())
+	if err != nil || len(pods) != 1 {
+		t.Fatalf("ListPods() = %v, %v", pods, err)
+	}
+	if pods[0].Status != "Init:CrashLoopBackOff" {
+		t.Errorf("Status = %q, want Init:CrashLoopBackOff", pods[0].Status)
+	}
+	var migrate domain.ContainerInfo
+	for _, ci := range pods[0].Containers {
+		if ci.Name == "migrate" {
+			migrate = ci
+		}
+	}
+	if migrate.Kind != domain.ContainerKindInit || migrate.State != "waiting" || migrate.Reason != "CrashLoopBackOff" {
+		t.Errorf("migrate = %+v, want a waiting init container in CrashLoopBackOff", migrate)
+	}
+}
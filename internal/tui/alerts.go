@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// alertWatchStartedMsg hands the background events watch over to the model.
+type alertWatchStartedMsg struct {
+	ch     <-chan domain.WatchEvent
+	cancel context.CancelFunc
+}
+
+// alertFailedMsg reports that the background events watch could not be
+// opened; alertRetryMsg opens it again after the backoff. attempt tells a
+// retry of the current failure from a stale one.
+type alertFailedMsg struct{ err error }
+type alertRetryMsg struct{ attempt int }
+
+// alertEventMsg and alertStoppedMsg carry the channel they come from so
+// that messages from a stopped watch are ignored.
+type alertEventMsg struct {
+	ch    <-chan domain.WatchEvent
+	event domain.WatchEvent
+}
+type alertStoppedMsg struct{ ch <-chan domain.WatchEvent }
+
+// alertState is the background Warning events watch. It runs whatever the
+// current view, unlike startWatch which only watches the view shown.
+type alertState struct {
+	ch      <-chan domain.WatchEvent
+	cancel  context.CancelFunc
+	last    map[string]time.Time // last notified occurrence per object/reason
+	unseen  int                  // badge of the Events tab
+	retries int                  // watch failures in a row
+	err     error                // last failure, shown in the status bar
+}
+
+// alertMaxBackoff caps the delay between two attempts to open the watch.
+const alertMaxBackoff = time.Minute
+
+// startAlerts opens the background events watch.
+func startAlerts(client domain.KubeGateway) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := client.WatchEvents(ctx)
+		if err != nil {
+			cancel()
+			return alertFailedMsg{err}
+		}
+		if ch == nil {
+			cancel()
+			return nil
+		}
+		return alertWatchStartedMsg{ch: ch, cancel: cancel}
+	}
+}
+
+// retry schedules the next attempt to open the watch, waiting twice as
+// long after each failure in a row.
+func (a *alertState) retry() tea.Cmd {
+	a.retries++
+	attempt := a.retries
+	delay := min(time.Second<<min(attempt-1, 6), alertMaxBackoff)
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return alertRetryMsg{attempt: attempt}
+	})
+}
+
+func (a *alertState) stop() {
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.ch, a.cancel = nil, nil
+}
+
+// isNew reports whether evt is a Warning occurrence not notified yet. The
+// watch starts from the current events, so only changes arrive; replays of
+// the same occurrence are skipped and a repeated event is new again once
+// its server timestamp moves on.
+func (a *alertState) isNew(evt domain.WatchEvent) bool {
+	e := evt.Event
+	if e == nil || evt.Type == domain.EventDeleted || e.Type != "Warning" {
+		return false
+	}
+	key := e.Namespace + "/" + e.Object + "/" + e.Reason
+	if last, ok := a.last[key]; ok && !e.CreatedAt.After(last) {
+		return false
+	}
+	if a.last == nil {
+		a.last = make(map[string]time.Time)
+	}
+	a.last[key] = e.CreatedAt
+	return true
+}
+
+// handleAlert notifies a new Warning event, unless the namespace events are
+// on screen already.
+func (m Model) handleAlert(evt domain.WatchEvent) (Model, tea.Cmd) {
+	if !m.alerts.isNew(evt) || !m.cfg.Alerts.Notifies(evt.Event.Reason) {
+		return m, nil
+	}
+	if m.view == ViewEvents && m.eventScope == nil {
+		return m, nil
+	}
+	m.alerts.unseen++
+	e := evt.Event
+	m.toast = newToast(fmt.Sprintf("⚠ %s %s : %s", e.Reason, e.Object, truncate(e.Message, 80)), toastError)
+	return m, scheduleToastClear()
+}
+
+// alertStatus flags in the status bar that alerts are off until the watch
+// opens again.
+func (a *alertState) status() string {
+	if a.err == nil {
+		return ""
+	}
+	return eventWarningStyle.Render(" ⚠ alertes indisponibles")
+}
+
+// alertBadge is the unseen Warning counter shown next to the Events tab.
+func (a *alertState) badge() string {
+	if a.unseen == 0 {
+		return ""
+	}
+	return " " + eventWarningStyle.Render(fmt.Sprintf("⚠%d", a.unseen))
+}
+
+func listenAlerts(ch <-chan domain.WatchEvent) tea.Cmd {
+	return func() tea.Msg {
+		evt, ok := <-ch
+		if !ok {
+			return alertStoppedMsg{ch}
+		}
+		return alertEventMsg{ch: ch, event: evt}
+	}
+}
@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func warningEvent(reason string, at time.Time) domain.WatchEvent {
+	return domain.WatchEvent{Type: domain.EventAdded, Resource: "event", Event: &domain.EventInfo{
+		Type: "Warning", Reason: reason, Object: "Pod/web-1", Message: "back-off restarting", CreatedAt: at,
+	}}
+}
+
+// startedAlertModel returns a model in the Pods view whose background
+// events watch reads from ch.
+func startedAlertModel(t *testing.T, cfg *config.AppConfig) (Model, chan domain.WatchEvent) {
+	t.Helper()
+	ch := make(chan domain.WatchEvent, 4)
+	mock := &domain.MockGateway{NamespaceVal: "default", WatchEventsCh: ch}
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.width = 120
+	m.height = 30
+
+	msg := startAlerts(mock)()
+	started, ok := msg.(alertWatchStartedMsg)
+	if !ok {
+		t.Fatalf("startAlerts() = %T, want alertWatchStartedMsg", msg)
+	}
+	updated, cmd := m.Update(started)
+	if cmd == nil {
+		t.Fatal("expected the model to listen to the alerts watch")
+	}
+	return updated.(Model), ch
+}
+
+func TestAlerts_WarningRaisesToastAndBadge(t *testing.T) {
+	m, ch := startedAlertModel(t, nil)
+
+	ch <- warningEvent("BackOff", time.Now())
+	updated, cmd := m.Update(listenAlerts(m.alerts.ch)())
+	m = updated.(Model)
+
+	if cmd == nil {
+		t.Error("expected toast clear and next listen")
+	}
+	if m.alerts.unseen != 1 {
+		t.Errorf("unseen = %d, want 1", m.alerts.unseen)
+	}
+	if !strings.Contains(m.toast.message, "BackOff") || !strings.Contains(m.toast.message, "Pod/web-1") {
+		t.Errorf("toast = %q, want reason and object", m.toast.message)
+	}
+	if !strings.Contains(m.renderTabs(), "⚠1") {
+		t.Error("Events tab should show the unseen counter")
+	}
+
+	// Opening the Events view clears the badge
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}})
+	m = updated.(Model)
+	if m.alerts.unseen != 0 {
+		t.Errorf("unseen = %d after opening Events, want 0", m.alerts.unseen)
+	}
+}
+
+func TestAlerts_SkipsNormalAndReplayedEvents(t *testing.T) {
+	var a alertState
+	// Server time, whatever the local clock says
+	at := time.Now().Add(-time.Hour)
+
+	normal := warningEvent("Pulled", at)
+	normal.Event.Type = "Normal"
+	if a.isNew(normal) {
+		t.Error("Normal events should be skipped")
+	}
+	if !a.isNew(warningEvent("BackOff", at)) {
+		t.Fatal("new Warning should be notified")
+	}
+	if a.isNew(warningEvent("BackOff", at)) {
+		t.Error("same occurrence should not be notified twice")
+	}
+	if !a.isNew(warningEvent("BackOff", at.Add(30*time.Second))) {
+		t.Error("repeated event with a later timestamp should be notified")
+	}
+}
+
+func TestAlerts_RetryAfterFailure(t *testing.T) {
+	mock := &domain.MockGateway{NamespaceVal: "default", WatchEventsErr: errors.New("connexion refusée")}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.width = 120
+	m.height = 30
+
+	msg := startAlerts(mock)()
+	if _, ok := msg.(alertFailedMsg); !ok {
+		t.Fatalf("startAlerts() = %T, want alertFailedMsg", msg)
+	}
+	updated, cmd := m.Update(msg)
+	m = updated.(Model)
+	if cmd == nil || m.alerts.retries != 1 {
+		t.Fatalf("retries = %d, want a retry scheduled", m.alerts.retries)
+	}
+	if !strings.Contains(m.renderStatusBar(), "alertes indisponibles") {
+		t.Error("the status bar should show that alerts are off")
+	}
+
+	// A stale retry is ignored, the current one opens the watch again
+	if _, cmd := m.Update(alertRetryMsg{attempt: 0}); cmd != nil {
+		t.Error("a stale retry should be ignored")
+	}
+	ch := make(chan domain.WatchEvent)
+	mock.WatchEventsErr, mock.WatchEventsCh = nil, ch
+	_, cmd = m.Update(alertRetryMsg{attempt: 1})
+	if cmd == nil {
+		t.Fatal("the retry should open the watch")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.alerts.ch == nil || m.alerts.err != nil || strings.Contains(m.renderStatusBar(), "alertes indisponibles") {
+		t.Error("the watch should be back and the status bar cleared")
+	}
+
+	// A closed watch is opened again after a backoff
+	close(ch)
+	updated, cmd = m.Update(listenAlerts(m.alerts.ch)())
+	m = updated.(Model)
+	if cmd == nil || m.alerts.ch != nil || m.alerts.retries != 2 {
+		t.Errorf("ch = %v, retries = %d, want the watch closed and a retry scheduled", m.alerts.ch, m.alerts.retries)
+	}
+}
+
+func TestAlerts_ReasonFilters(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Alerts.IgnoreReasons = []string{"Unhealthy"}
+	m, ch := startedAlertModel(t, cfg)
+
+	ch <- warningEvent("Unhealthy", time.Now())
+	updated, _ := m.Update(listenAlerts(m.alerts.ch)())
+	m = updated.(Model)
+
+	if m.alerts.unseen != 0 || m.toast.message != "" {
+		t.Errorf("ignored reason notified: unseen = %d, toast = %q", m.alerts.unseen, m.toast.message)
+	}
+}
+
+func TestAlerts_QuietInEventsView(t *testing.T) {
+	m, ch := startedAlertModel(t, nil)
+	m.view = ViewEvents
+
+	ch <- warningEvent("BackOff", time.Now())
+	updated, _ := m.Update(listenAlerts(m.alerts.ch)())
+	m = updated.(Model)
+
+	if m.alerts.unseen != 0 {
+		t.Errorf("unseen = %d, want 0 while the events are on screen", m.alerts.unseen)
+	}
+}
+
+func TestAlerts_DisabledDoesNotWatch(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Alerts.Disabled = true
+	m := NewModel(&domain.MockGateway{NamespaceVal: "default"}, nil, cfg)
+	if m.startAlerts() != nil {
+		t.Error("disabled alerts should not open a watch")
+	}
+}
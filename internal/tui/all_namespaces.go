@@ -0,0 +1,96 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// allNamespacesLabel stands for the namespace in the context and status bars
+// while every namespace is listed.
+const allNamespacesLabel = "(tous)"
+
+// allNamespaces reports whether the lists span every namespace.
+func (m Model) allNamespaces() bool {
+	return m.client != nil && m.client.AllNamespaces()
+}
+
+// targetRow records the namespace of the row an action applies to. Outside
+// the all-namespaces mode actions stay on the current namespace.
+func (m *Model) targetRow(ns string) {
+	if m.allNamespaces() {
+		m.targetNS = ns
+	} else {
+		m.targetNS = ""
+	}
+}
+
+// actionClient is the gateway row actions go through: the current one, or a
+// copy bound to the row's namespace in all-namespaces mode.
+func (m Model) actionClient() domain.KubeGateway {
+	if m.targetNS == "" || !m.allNamespaces() {
+		return m.client
+	}
+	return m.client.InNamespace(m.targetNS)
+}
+
+// targetNamespace is the namespace of the row an action applies to, used for
+// the prod and readonly checks as well as exec and copy.
+func (m Model) targetNamespace() string {
+	if m.targetNS == "" || !m.allNamespaces() {
+		return m.client.GetNamespace()
+	}
+	return m.targetNS
+}
+
+// namespaceLabel is the namespace shown in the context and status bars.
+func (m Model) namespaceLabel() string {
+	if m.allNamespaces() {
+		return allNamespacesLabel
+	}
+	return m.client.GetNamespace()
+}
+
+// toggleAllNamespaces switches the Pods, Deployments and Events views between
+// the current namespace and every namespace.
+func (m Model) toggleAllNamespaces() (tea.Model, tea.Cmd) {
+	on := !m.client.AllNamespaces()
+	m.stopWatch()
+	m.stopTimeline()
+	m.client.SetAllNamespaces(on)
+	m.targetNS = ""
+	m.eventScope = nil
+	m.marks = nil
+	m.pods = nil
+	m.deployments = nil
+	m.events = nil
+	m.cursor = 0
+	m.filter.SetValue("")
+	m.loading = true
+	// Alerts follow the listed namespaces
+	m.alerts.stop()
+	m.alerts.unseen = 0
+	msg := "Namespace : " + m.client.GetNamespace()
+	if on {
+		msg = "Tous les namespaces"
+	}
+	m.toast = newToast(msg, toastInfo)
+	return m, tea.Batch(m.loadCurrentView(), m.startAlerts(), scheduleToastClear())
+}
+
+// namespaceColumn is the NAMESPACE column of the lists: always shown in
+// all-namespaces mode, with the extended columns otherwise.
+func namespaceColumn(allNS bool) column {
+	if allNS {
+		return column{title: "NAMESPACE", width: 20, priority: 2}
+	}
+	return column{title: "NAMESPACE", width: 20, from: widthExtended, priority: 9}
+}
+
+// listScope completes the empty-list messages.
+func listScope(allNS bool) string {
+	if allNS {
+		return "dans les namespaces accessibles"
+	}
+	return "dans ce namespace"
+}
@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func TestAllNamespaces_Toggle(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Namespace: "team-a", Status: "Running"},
+			{Name: "web-1", Namespace: "team-b", Status: "Pending"},
+		},
+		WatchPodsCh:   make(chan domain.WatchEvent),
+		WatchEventsCh: make(chan domain.WatchEvent),
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.width = 120
+	m.height = 30
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	m = updated.(Model)
+	if !mock.AllNamespacesVal {
+		t.Fatal("A should switch the gateway to all namespaces")
+	}
+	if cmd == nil {
+		t.Fatal("expected a cmd reloading the view")
+	}
+	m.pods = mock.Pods
+	m.loading = false
+
+	view := m.View()
+	if !strings.Contains(view, "NAMESPACE") || !strings.Contains(view, "team-b") {
+		t.Error("all-namespaces mode should show the NAMESPACE column")
+	}
+	if !strings.Contains(m.renderContextBar(), allNamespacesLabel) {
+		t.Error("context bar should show every namespace is listed")
+	}
+
+	m.filter.SetValue("team-b")
+	if pods := m.filteredPods(); len(pods) != 1 || pods[0].Namespace != "team-b" {
+		t.Errorf("filter on namespace = %+v, want the team-b pod", pods)
+	}
+	m.filter.SetValue("")
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	m = updated.(Model)
+	if mock.AllNamespacesVal {
+		t.Error("A again should go back to the current namespace")
+	}
+	if strings.Contains(renderPodList(mock.Pods, 0, 120, 10, false, nil, nil), "NAMESPACE") {
+		t.Error("NAMESPACE column should be hidden outside all-namespaces mode")
+	}
+}
+
+func TestAllNamespaces_ActionsUseRowNamespace(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal:     "default",
+		AllNamespacesVal: true,
+		Pods: []domain.PodInfo{
+			{Name: "api", Namespace: "dev", Status: "Running"},
+			{Name: "api", Namespace: "prod-eu", Status: "Running"},
+		},
+	}
+	cfg := config.DefaultConfig()
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.cursor = 1
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = updated.(Model)
+	if m.confirm.namespace != "prod-eu" {
+		t.Errorf("confirm namespace = %q, want prod-eu", m.confirm.namespace)
+	}
+	if !m.confirm.isProd {
+		t.Error("prod check should use the namespace of the row")
+	}
+
+	msg := m.confirm.callback()
+	if _, ok := msg.(actionDoneMsg); !ok {
+		t.Fatalf("delete msg = %T, want actionDoneMsg", msg)
+	}
+	if mock.DeletedPod != "api" {
+		t.Errorf("deleted pod = %q, want api", mock.DeletedPod)
+	}
+	if n := len(mock.RoutedNamespaces); n == 0 || mock.RoutedNamespaces[n-1] != "prod-eu" {
+		t.Errorf("routed namespaces = %v, want prod-eu last", mock.RoutedNamespaces)
+	}
+}
+
+func TestAllNamespaces_CurrentNamespaceNotRouted(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods:         []domain.PodInfo{{Name: "api", Namespace: "default", Status: "Running"}},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = updated.(Model)
+	m.confirm.callback()
+	if len(mock.RoutedNamespaces) != 0 {
+		t.Errorf("routed namespaces = %v, want none outside all-namespaces mode", mock.RoutedNamespaces)
+	}
+}
+
+func TestMergePodEvent_SameNameOtherNamespace(t *testing.T) {
+	m := Model{pods: []domain.PodInfo{
+		{Name: "web", Namespace: "a", Status: "Running"},
+		{Name: "web", Namespace: "b", Status: "Running"},
+	}}
+	m.mergePodEvent(domain.WatchEvent{Type: domain.EventModified,
+		Pod: &domain.PodInfo{Name: "web", Namespace: "b", Status: "Failed"}})
+	if m.pods[0].Status != "Running" || m.pods[1].Status != "Failed" {
+		t.Errorf("pods = %+v, want only the b pod updated", m.pods)
+	}
+
+	m.mergePodEvent(domain.WatchEvent{Type: domain.EventDeleted,
+		Pod: &domain.PodInfo{Name: "web", Namespace: "a"}})
+	if len(m.pods) != 1 || m.pods[0].Namespace != "b" {
+		t.Errorf("pods = %+v, want only the b pod left", m.pods)
+	}
+}
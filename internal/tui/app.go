@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -30,6 +31,9 @@ const (
 	ViewEvents
 	ViewLogs
 	ViewYAML
+	ViewCommand
+	ViewContexts
+	ViewOverview
 	ViewError // startup error screen
 )
 
@@ -47,6 +51,12 @@ func (v View) String() string {
 		return "LOGS"
 	case ViewYAML:
 		return "YAML"
+	case ViewCommand:
+		return "CMD"
+	case ViewContexts:
+		return "CONTEXTS"
+	case ViewOverview:
+		return "OVERVIEW"
 	default:
 		return ""
 	}
@@ -60,17 +70,28 @@ type deploymentsLoadedMsg struct{ items []domain.DeploymentInfo }
 type eventsLoadedMsg struct{ items []domain.EventInfo }
 type logsLoadedMsg struct{ content string }
 type yamlLoadedMsg struct{ content string }
+type commandDoneMsg struct{ result domain.CommandResult }
+type debugReadyMsg struct{ podName, containerName string }
 type actionDoneMsg struct{ message string }
 type apiErrMsg struct{ err error }
 type execDoneMsg struct{ err error }
 type watchEventMsg struct{ event domain.WatchEvent }
 type watchStoppedMsg struct{ resource string }
 
+// logLinesMsg and logStreamEndedMsg carry the channel they come from so that
+// messages from a stopped stream are ignored.
+type logLinesMsg struct {
+	ch    <-chan domain.LogLine
+	lines []domain.LogLine
+}
+type logStreamEndedMsg struct{ ch <-chan domain.LogLine }
+
 // --- Model ---
 
 type Model struct {
 	client        domain.KubeGateway
 	clientFactory ClientFactory
+	ctxGen        int // context generation, see ctxMsg
 
 	// Views
 	view     View
@@ -81,8 +102,10 @@ type Model struct {
 	pods        []domain.PodInfo
 	deployments []domain.DeploymentInfo
 	events      []domain.EventInfo
-	logState    logState
-	yamlState   yamlViewState
+	contexts    []domain.ContextInfo
+	logState     logState
+	yamlState    yamlViewState
+	commandState commandState
 
 	// UI state
 	cursor    int
@@ -101,13 +124,47 @@ type Model struct {
 	scaleInput    textinput.Model
 	scalingDep    string
 	scaleActive   bool
+	scaleTargets  []bulkTarget // marked deployments, scaled together
 
 	// Container selector (multi-container pods)
 	containerSelector bool
 	containerChoices  []string
+	containerDetails  []string // kind and state, shown for logs
 	containerCursor   int
 	containerPodName        string
-	containerSelectorAction string // "logs" or "exec"
+	containerSelectorAction string // "logs", "exec", "run", "debug", "download" or "upload"
+
+	// Run command prompt
+	commandInput      textinput.Model
+	commandActive     bool
+	commandPodName    string
+	commandContainer  string
+	commandHistory    map[string][]string // per namespace, most recent first
+	commandHistoryIdx int                 // -1 when not browsing history
+
+	// Label selector prompt (aggregated logs)
+	labelInput  textinput.Model
+	labelActive bool
+
+	// Log search prompt
+	logSearchInput textinput.Model
+	logSearching   bool
+
+	// Log grep filter prompt
+	grepInput  textinput.Model
+	grepActive bool
+
+	// Log JSON field filter prompt
+	fieldInput  textinput.Model
+	fieldActive bool
+
+	// File copy prompt and progress
+	fileCopy copyState
+
+	// Marked rows of the pods or deployments view, by rowKey, and the bulk
+	// action running on them
+	marks map[string]bool
+	bulk  bulkState
 
 	// Connection state
 	disconnected bool
@@ -117,6 +174,31 @@ type Model struct {
 	watching    bool
 	watchCh     <-chan domain.WatchEvent
 
+	// Events view scoped to one object (nil: whole namespace)
+	eventScope *domain.ObjectRef
+
+	// Namespace of the row acted upon in all-namespaces mode
+	targetNS string
+
+	// Cluster health summary (nil until loaded)
+	overview *overviewData
+
+	// ":" command prompt
+	palette paletteState
+
+	// Full-screen help overlay
+	help helpState
+
+	// Background Warning events watch
+	alerts alertState
+
+	// Events timeline (pod transitions watch)
+	timeline timelineState
+
+	// Log follow stream
+	logCancel context.CancelFunc
+	logCh     <-chan domain.LogLine
+
 	// Sort
 	sortState map[View]SortState
 
@@ -139,12 +221,45 @@ func NewModel(client domain.KubeGateway, factory ClientFactory, cfg *config.AppC
 	si.CharLimit = 4
 	si.Width = 20
 
+	li := textinput.New()
+	li.Placeholder = "app=web,tier!=db"
+	li.CharLimit = 256
+	li.Width = 50
+
+	lsi := textinput.New()
+	lsi.Placeholder = "regex..."
+	lsi.CharLimit = 128
+	lsi.Width = 40
+
+	gi := textinput.New()
+	gi.Placeholder = "timeout -B 2 -A 5"
+	gi.CharLimit = 128
+	gi.Width = 40
+
+	jfi := textinput.New()
+	jfi.Placeholder = "trace_id=4bf92f3577b34da6"
+	jfi.CharLimit = 128
+	jfi.Width = 40
+
+	ci := textinput.New()
+	ci.Placeholder = "env, df -h, cat /etc/config.yaml..."
+	ci.CharLimit = 256
+	ci.Width = 60
+
 	return Model{
-		client:        client,
-		clientFactory: factory,
-		view:          ViewPods,
-		filter:        fi,
-		scaleInput:    si,
+		client:         client,
+		clientFactory:  factory,
+		view:           ViewOverview,
+		filter:         fi,
+		scaleInput:     si,
+		commandInput:   ci,
+		labelInput:     li,
+		logSearchInput: lsi,
+		grepInput:      gi,
+		fieldInput:     jfi,
+		commandHistory: make(map[string][]string),
+		fileCopy:       newCopyState(),
+		palette:        paletteState{input: newPaletteInput(), historyIdx: -1},
 		confirm:       newConfirmState(),
 		sortState:     make(map[View]SortState),
 		cfg:           cfg,
@@ -164,7 +279,16 @@ func (m Model) Init() tea.Cmd {
 	if m.view == ViewError {
 		return nil
 	}
-	return m.loadCurrentView()
+	return tea.Batch(m.loadCurrentView(), m.startAlerts())
+}
+
+// startAlerts opens the background Warning events watch unless alerts are
+// disabled.
+func (m Model) startAlerts() tea.Cmd {
+	if m.cfg == nil || m.cfg.Alerts.Disabled {
+		return nil
+	}
+	return m.inContext(startAlerts(m.client))
 }
 
 // --- Update ---
@@ -210,6 +334,31 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := m.startWatch()
 		return m, cmd
 
+	case contextsLoadedMsg:
+		m.contexts = msg.items
+		m.loading = false
+		m.cursor = 0
+		return m, nil
+
+	case contextSwitchedMsg:
+		return m.contextSwitched(msg)
+
+	case ctxMsg:
+		return m.handleCtxMsg(msg)
+
+	case paletteDataMsg:
+		return m.handlePaletteData(msg), nil
+
+	case paletteHistoryMsg:
+		return m.handlePaletteHistory(msg)
+
+	case overviewLoadedMsg:
+		m.overview = msg.data
+		m.loading = false
+		m.disconnected = false
+		m.cursor = min(m.cursor, max(m.listLen()-1, 0))
+		return m, nil
+
 	case watchEventMsg:
 		switch msg.event.Resource {
 		case "pod":
@@ -220,7 +369,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.mergeEventEvent(msg.event)
 		}
 		if m.watchCh != nil {
-			return m, listenWatch(m.watchCh, msg.event.Resource)
+			return m, m.inContext(listenWatch(m.watchCh, msg.event.Resource))
 		}
 		return m, nil
 
@@ -229,16 +378,132 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := m.startWatch()
 		return m, cmd
 
+	case alertWatchStartedMsg:
+		m.alerts.stop()
+		m.alerts.ch, m.alerts.cancel = msg.ch, msg.cancel
+		m.alerts.err = nil
+		return m, listenAlerts(msg.ch)
+
+	case alertFailedMsg:
+		m.alerts.err = msg.err
+		return m, m.alerts.retry()
+
+	case alertRetryMsg:
+		if msg.attempt != m.alerts.retries || m.alerts.ch != nil {
+			return m, nil
+		}
+		return m, m.startAlerts()
+
+	case alertEventMsg:
+		if msg.ch != m.alerts.ch || m.alerts.ch == nil {
+			return m, nil
+		}
+		m.alerts.retries = 0
+		m, cmd := m.handleAlert(msg.event)
+		return m, tea.Batch(cmd, listenAlerts(m.alerts.ch))
+
+	case alertStoppedMsg:
+		if msg.ch != m.alerts.ch || m.alerts.ch == nil {
+			return m, nil
+		}
+		// The server ends watches from time to time: open it again, backing
+		// off when it keeps closing
+		m.alerts.stop()
+		return m, m.alerts.retry()
+
+	case timelineSeedMsg:
+		if !m.timeline.active || m.view != ViewEvents {
+			return m, nil
+		}
+		m.timeline.seed(msg.pods)
+		return m, m.startTimelineWatch()
+
+	case timelinePodMsg:
+		if msg.ch != m.timeline.ch || m.timeline.ch == nil {
+			return m, nil
+		}
+		m.timeline.observe(msg.event, time.Now())
+		return m, listenTimeline(m.timeline.ch)
+
+	case timelineStoppedMsg:
+		if msg.ch != m.timeline.ch || m.timeline.ch == nil {
+			return m, nil
+		}
+		return m, m.startTimelineWatch()
+
 	case logsLoadedMsg:
-		m.logState.setContent(msg.content)
+		m.logState.setTimestampedContent(msg.content)
 		m.loading = false
 		return m, nil
 
+	case logLinesMsg:
+		if msg.ch != m.logCh || m.logCh == nil {
+			return m, nil
+		}
+		m.logState.appendLogLines(msg.lines, m.contentHeight())
+		return m, listenLogs(m.logCh)
+
+	case logStreamEndedMsg:
+		if msg.ch != m.logCh || m.logCh == nil {
+			return m, nil
+		}
+		m.stopLogFollow()
+		m.toast = newToast("Flux de logs terminé", toastInfo)
+		return m, scheduleToastClear()
+
 	case yamlLoadedMsg:
 		m.yamlState.setContent(msg.content)
 		m.loading = false
 		return m, nil
 
+	case copyProgressMsg:
+		if m.fileCopy.progress == nil {
+			return m, nil
+		}
+		m.fileCopy.bytes = msg.bytes
+		return m, listenCopyProgress(m.fileCopy.progress)
+
+	case copyConfirmedMsg:
+		return m.startCopy()
+
+	case copyDoneMsg:
+		m.fileCopy.running = false
+		m.fileCopy.progress = nil
+		if msg.err != nil {
+			m.toast = newToast(fmt.Sprintf("Copie : %v", msg.err), toastError)
+		} else if msg.direction == copyDownload {
+			m.toast = newToast(fmt.Sprintf("Téléchargé → %s (%s)", msg.dest, formatBytes(msg.bytes)), toastSuccess)
+		} else {
+			m.toast = newToast(fmt.Sprintf("Envoyé → %s (%s)", msg.dest, formatBytes(msg.bytes)), toastSuccess)
+		}
+		return m, scheduleToastClear()
+
+	case bulkStartedMsg:
+		return m.handleBulkStarted(msg)
+	case bulkResultMsg:
+		return m.handleBulkResult(msg)
+	case bulkDoneMsg:
+		return m.handleBulkDone(msg)
+
+	case exportDoneMsg:
+		if msg.err != nil {
+			m.toast = newToast(fmt.Sprintf("Export : %v", msg.err), toastError)
+		} else if msg.filtered {
+			m.toast = newToast(fmt.Sprintf("Exporté → %s (%d lignes, filtré)", msg.path, msg.lines), toastSuccess)
+		} else {
+			m.toast = newToast(fmt.Sprintf("Exporté → %s (%d lignes)", msg.path, msg.lines), toastSuccess)
+		}
+		return m, scheduleToastClear()
+
+	case debugReadyMsg:
+		m.loading = false
+		return m.startExec(msg.podName, msg.containerName)
+
+	case commandDoneMsg:
+		m.commandState.setResult(msg.result)
+		m.loading = false
+		return m, nil
+
 	case execDoneMsg:
 		if msg.err != nil {
 			m.toast = newToast(fmt.Sprintf("Exec: %v", msg.err), toastError)
@@ -281,11 +546,16 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.client = newClient
 			m.startupErr = nil
 			m.view = ViewPods
-			return m, m.loadCurrentView()
+			return m, tea.Batch(m.loadCurrentView(), m.startAlerts())
 		}
 		return m, nil
 	}
 
+	// Help overlay captures all input
+	if m.help.active {
+		return m.handleHelpKey(msg)
+	}
+
 	// Confirm dialog captures all input
 	if m.confirm.isActive() {
 		cmd, handled := m.confirm.update(msg)
@@ -305,15 +575,52 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleScaleInput(msg)
 	}
 
+	// Run command prompt captures all input
+	if m.commandActive {
+		return m.handleCommandInput(msg)
+	}
+
+	// Label selector prompt captures all input
+	if m.labelActive {
+		return m.handleLabelInput(msg)
+	}
+
+	// Log search prompt captures all input
+	if m.logSearching {
+		return m.handleLogSearchInput(msg)
+	}
+
+	// Log grep prompt captures all input
+	if m.grepActive {
+		return m.handleGrepInput(msg)
+	}
+
+	// Log field filter prompt captures all input
+	if m.fieldActive {
+		return m.handleFieldInput(msg)
+	}
+
+	// File copy prompt captures all input
+	if m.fileCopy.isActive() {
+		return m.handleCopyInput(msg)
+	}
+
+	// Command prompt captures all input
+	if m.palette.active {
+		return m.handlePaletteInput(msg)
+	}
+
 	// Filter mode
 	if m.filtering {
 		return m.handleFilterInput(msg)
 	}
 
-	// Global keys
+	// Global keys first. The keys of a view are guarded in their case, so
+	// that a key remapped to different actions in two views reaches both.
 	switch {
 	case key.Matches(msg, keys.Quit):
 		if m.view == ViewLogs {
+			m.stopLogFollow()
 			m.view = m.prevView
 			m.logState = logState{}
 			return m, nil
@@ -323,11 +630,24 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.yamlState = yamlViewState{}
 			return m, nil
 		}
-		m.stopWatch()
-		return m, tea.Quit
+		if m.view == ViewCommand {
+			m.view = m.prevView
+			m.commandState = commandState{}
+			return m, nil
+		}
+		return m.quit()
 
 	case key.Matches(msg, keys.Escape):
+		if m.view == ViewLogs && m.logState.search != nil {
+			m.logState.clearSearch()
+			return m, nil
+		}
+		if m.view == ViewLogs && m.logState.filtered() {
+			m.logState.clearFilters(m.contentHeight())
+			return m, nil
+		}
 		if m.view == ViewLogs {
+			m.stopLogFollow()
 			m.view = m.prevView
 			m.logState = logState{}
 			return m, nil
@@ -337,10 +657,30 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.yamlState = yamlViewState{}
 			return m, nil
 		}
+		if m.view == ViewCommand {
+			m.view = m.prevView
+			m.commandState = commandState{}
+			return m, nil
+		}
+		if m.view == ViewEvents && m.eventScope != nil {
+			return m.switchView(m.prevView)
+		}
+		if len(m.marks) > 0 {
+			m.marks = nil
+			return m, nil
+		}
 		m.toast = toast{}
 		return m, nil
 
+	case key.Matches(msg, keys.Palette):
+		return m.openPalette()
+	case key.Matches(msg, keys.Help):
+		m.help = helpState{active: true}
+		return m, nil
+
 	// Tab switching
+	case key.Matches(msg, keys.Tab0):
+		return m.switchView(ViewOverview)
 	case key.Matches(msg, keys.Tab1):
 		return m.switchView(ViewProjects)
 	case key.Matches(msg, keys.Tab2):
@@ -349,6 +689,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.switchView(ViewDeployments)
 	case key.Matches(msg, keys.Tab4):
 		return m.switchView(ViewEvents)
+	case key.Matches(msg, keys.Tab5):
+		return m.switchView(ViewContexts)
 	case key.Matches(msg, keys.TabNext):
 		next := (m.view + 1) % 4 // cycle through Projects/Pods/Deployments/Events
 		return m.switchView(View(next))
@@ -361,9 +703,61 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.filter.Focus()
 			return m, textinput.Blink
 		}
+		m.logSearching = true
+		m.logSearchInput.SetValue(m.logState.searchPattern)
+		m.logSearchInput.CursorEnd()
+		m.logSearchInput.Focus()
+		return m, textinput.Blink
+	case key.Matches(msg, keys.NextHit) && m.view == ViewLogs:
+		m.logState.nextMatch(1, m.contentHeight())
+		return m, nil
+	case key.Matches(msg, keys.PrevHit) && m.view == ViewLogs:
+		m.logState.nextMatch(-1, m.contentHeight())
+		return m, nil
+	case key.Matches(msg, keys.Level) && m.view == ViewLogs:
+		m.logState.cycleLevel(m.contentHeight())
+		return m, nil
+	case key.Matches(msg, keys.Grep) && m.view == ViewLogs:
+		m.grepActive = true
+		m.grepInput.SetValue("")
+		if m.logState.grep != nil {
+			m.grepInput.SetValue(strings.TrimPrefix(m.logState.grep.String(), "/"))
+			m.grepInput.CursorEnd()
+		}
+		m.grepInput.Focus()
+		return m, textinput.Blink
+	case key.Matches(msg, keys.Range) && m.view == ViewLogs:
+		return m.cycleLogRange()
+	case key.Matches(msg, keys.Times) && m.view == ViewLogs:
+		m.logState.showTimes = !m.logState.showTimes
+		return m, nil
+	case key.Matches(msg, keys.Export) && m.view == ViewLogs:
+		return m.exportLogs()
+	case key.Matches(msg, keys.Export) && m.view == ViewYAML:
+		return m.exportYAML()
+	case key.Matches(msg, keys.JSON) && m.view == ViewLogs:
+		m.logState.cycleJSONMode(m.cfg.Logs.JSONFields, m.contentHeight())
+		return m, nil
+	case key.Matches(msg, keys.FieldFlt) && m.view == ViewLogs:
+		m.fieldActive = true
+		m.fieldInput.SetValue("")
+		if m.logState.field != nil {
+			m.fieldInput.SetValue(m.logState.field.String())
+			m.fieldInput.CursorEnd()
+		}
+		m.fieldInput.Focus()
+		return m, textinput.Blink
 
 	// Refresh
 	case key.Matches(msg, keys.Refresh):
+		if m.view == ViewLogs {
+			// Streams are live already; snapshots are fetched again
+			if m.logState.following || m.logState.aggregated() {
+				return m, nil
+			}
+			m.loading = true
+			return m, m.fetchLogs()
+		}
 		if m.disconnected && m.client != nil {
 			_ = m.client.Reconnect()
 			m.disconnected = false
@@ -377,6 +771,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.logState.scrollDown(1, m.contentHeight())
 		} else if m.view == ViewYAML {
 			m.yamlState.scrollDown(1, m.contentHeight())
+		} else if m.view == ViewCommand {
+			m.commandState.scrollDown(1, m.contentHeight())
 		} else {
 			maxIdx := m.listLen() - 1
 			if maxIdx < 0 {
@@ -389,14 +785,18 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.logState.scrollUp(1)
 		} else if m.view == ViewYAML {
 			m.yamlState.scrollUp(1)
+		} else if m.view == ViewCommand {
+			m.commandState.scrollUp(1)
 		} else {
 			m.cursor = max(m.cursor-1, 0)
 		}
 	case key.Matches(msg, keys.Top):
 		if m.view == ViewLogs {
-			m.logState.offset = 0
+			m.logState.jumpToTop()
 		} else if m.view == ViewYAML {
 			m.yamlState.offset = 0
+		} else if m.view == ViewCommand {
+			m.commandState.offset = 0
 		} else {
 			m.cursor = 0
 		}
@@ -405,6 +805,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.logState.jumpToBottom(m.contentHeight())
 		} else if m.view == ViewYAML {
 			m.yamlState.jumpToBottom(m.contentHeight())
+		} else if m.view == ViewCommand {
+			m.commandState.jumpToBottom(m.contentHeight())
 		} else {
 			m.cursor = max(m.listLen()-1, 0)
 		}
@@ -413,6 +815,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.logState.scrollDown(20, m.contentHeight())
 		} else if m.view == ViewYAML {
 			m.yamlState.scrollDown(20, m.contentHeight())
+		} else if m.view == ViewCommand {
+			m.commandState.scrollDown(20, m.contentHeight())
 		} else {
 			m.cursor = min(m.cursor+20, max(m.listLen()-1, 0))
 		}
@@ -421,6 +825,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.logState.scrollUp(20)
 		} else if m.view == ViewYAML {
 			m.yamlState.scrollUp(20)
+		} else if m.view == ViewCommand {
+			m.commandState.scrollUp(20)
 		} else {
 			m.cursor = max(m.cursor-20, 0)
 		}
@@ -430,46 +836,57 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleEnter()
 
 	// Actions
-	case key.Matches(msg, keys.Delete):
-		if m.view == ViewPods {
-			return m.handleDeletePod()
-		}
-	case key.Matches(msg, keys.ScaleUp):
-		if m.view == ViewDeployments {
-			return m.handleScaleDelta(1)
-		}
-	case key.Matches(msg, keys.ScaleDn):
-		if m.view == ViewDeployments {
-			return m.handleScaleDelta(-1)
-		}
-	case key.Matches(msg, keys.ScaleSet):
-		if m.view == ViewDeployments {
-			return m.activateScaleInput()
-		}
-		if m.view == ViewPods {
-			return m.handleExecPod()
-		}
-	case key.Matches(msg, keys.Previous):
-		if m.view == ViewLogs {
-			return m.togglePreviousLogs()
-		}
-	case key.Matches(msg, keys.Wrap):
-		if m.view == ViewLogs {
-			m.logState.wrap = !m.logState.wrap
-			return m, nil
-		}
-	case key.Matches(msg, keys.YAML):
-		if m.view == ViewPods || m.view == ViewDeployments {
-			return m.handleYAML()
-		}
-	case key.Matches(msg, keys.Sort):
-		if m.view == ViewPods || m.view == ViewDeployments || m.view == ViewEvents {
-			return m.cycleSort()
-		}
-	case key.Matches(msg, keys.Copy):
-		if m.view == ViewPods {
-			return m.copyPodName()
-		}
+	case key.Matches(msg, keys.Delete) && m.view == ViewPods:
+		return m.handleDeletePod()
+	case key.Matches(msg, keys.ScaleUp) && m.view == ViewDeployments:
+		return m.handleScaleDelta(1)
+	case key.Matches(msg, keys.ScaleDn) && m.view == ViewDeployments:
+		return m.handleScaleDelta(-1)
+	case key.Matches(msg, keys.ScaleSet) && m.view == ViewDeployments:
+		return m.activateScaleInput()
+	case key.Matches(msg, keys.Restart) && m.view == ViewDeployments:
+		return m.handleRestartDeployment()
+	case key.Matches(msg, keys.Mark) && (m.view == ViewPods || m.view == ViewDeployments):
+		return m.toggleMark()
+	case key.Matches(msg, keys.MarkAll) && (m.view == ViewPods || m.view == ViewDeployments):
+		return m.markAll()
+	case key.Matches(msg, keys.Invert) && (m.view == ViewPods || m.view == ViewDeployments):
+		return m.invertMarks()
+	case key.Matches(msg, keys.Shell) && m.view == ViewPods:
+		return m.handleExecPod()
+	case key.Matches(msg, keys.Previous) && m.view == ViewLogs:
+		return m.togglePreviousLogs()
+	case key.Matches(msg, keys.Wrap) && m.view == ViewLogs:
+		m.logState.wrap = !m.logState.wrap
+		return m, nil
+	case key.Matches(msg, keys.Follow) && m.view == ViewLogs:
+		return m.toggleFollowLogs()
+	case key.Matches(msg, keys.YAML) && (m.view == ViewPods || m.view == ViewDeployments):
+		return m.handleYAML()
+	case key.Matches(msg, keys.ObjEvts) && (m.view == ViewPods || m.view == ViewDeployments):
+		return m.handleObjectEvents()
+	case key.Matches(msg, keys.AllNS) && (m.view == ViewPods || m.view == ViewDeployments || m.view == ViewEvents):
+		return m.toggleAllNamespaces()
+	case key.Matches(msg, keys.Timeline) && m.view == ViewEvents:
+		return m.toggleTimeline()
+	case key.Matches(msg, keys.Sort) && (m.view == ViewPods || m.view == ViewDeployments || m.view == ViewEvents):
+		return m.cycleSort()
+	case key.Matches(msg, keys.Copy) && m.view == ViewPods:
+		return m.copyPodName()
+	case key.Matches(msg, keys.RunCmd) && m.view == ViewPods:
+		return m.handleRunCommand()
+	case key.Matches(msg, keys.Debug) && m.view == ViewPods:
+		return m.handleDebugPod()
+	case key.Matches(msg, keys.LabelLog) && m.view == ViewPods:
+		m.targetRow("")
+		m.labelActive = true
+		m.labelInput.SetValue("")
+		m.labelInput.Focus()
+		return m, textinput.Blink
+	case key.Matches(msg, keys.Download) && m.view == ViewPods:
+		return m.handleCopyPod(copyDownload)
+	case key.Matches(msg, keys.Upload) && m.view == ViewPods:
+		return m.handleCopyPod(copyUpload)
 	}
 
 	return m, nil
@@ -501,10 +918,13 @@ func (m Model) handleScaleInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.scaleActive = false
 		m.scaleInput.Blur()
 		m.scaleInput.SetValue("")
+		m.scaleTargets = nil
 		return m, nil
 	case "enter":
 		val := strings.TrimSpace(m.scaleInput.Value())
 		replicas, err := strconv.Atoi(val)
+		targets := m.scaleTargets
+		m.scaleTargets = nil
 		if err != nil || replicas < 0 {
 			m.toast = newToast("Nombre invalide", toastError)
 			m.scaleActive = false
@@ -513,33 +933,13 @@ func (m Model) handleScaleInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		m.scaleActive = false
 		m.scaleInput.Blur()
-		depName := m.scalingDep
-		r := int32(replicas)
-
-		if r > 10 {
-			isProd := config.IsProdNamespace(m.client.GetNamespace(), m.cfg.ProdPatterns)
-			m.confirm.activate(
-				fmt.Sprintf("Scale %s à %d replicas", depName, r),
-				depName, m.client.GetNamespace(), isProd,
-				func() tea.Msg {
-					err := m.client.ScaleDeployment(context.Background(), depName, r)
-					if err != nil {
-						return apiErrMsg{err}
-					}
-					return actionDoneMsg{fmt.Sprintf("Scaled %s à %d", depName, r)}
-				},
-			)
-			return m, nil
-		}
-
-		m.loading = true
-		return m, func() tea.Msg {
-			err := m.client.ScaleDeployment(context.Background(), depName, r)
-			if err != nil {
-				return apiErrMsg{err}
+		if len(targets) > 0 {
+			for i := range targets {
+				targets[i].replicas = int32(replicas)
 			}
-			return actionDoneMsg{fmt.Sprintf("Scaled %s à %d", depName, r)}
+			return m.confirmBulk(bulkScale, "Scale", "deployment", targets)
 		}
+		return m.scaleTo(m.scalingDep, int32(replicas))
 	default:
 		var cmd tea.Cmd
 		m.scaleInput, cmd = m.scaleInput.Update(msg)
@@ -547,50 +947,96 @@ func (m Model) handleScaleInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 }
 
+// scaleTo sets the replicas of a deployment, asking for confirmation above
+// 10 replicas.
+func (m Model) scaleTo(depName string, r int32) (tea.Model, tea.Cmd) {
+	if r > 10 {
+		isProd := config.IsProdNamespace(m.targetNamespace(), m.cfg.ProdPatterns)
+		m.confirm.activate(
+			fmt.Sprintf("Scale %s à %d replicas", depName, r),
+			depName, m.targetNamespace(), isProd,
+			func() tea.Msg {
+				err := m.actionClient().ScaleDeployment(context.Background(), depName, r)
+				if err != nil {
+					return apiErrMsg{err}
+				}
+				return actionDoneMsg{fmt.Sprintf("Scaled %s à %d", depName, r)}
+			},
+		)
+		return m, nil
+	}
+
+	m.loading = true
+	return m, func() tea.Msg {
+		err := m.actionClient().ScaleDeployment(context.Background(), depName, r)
+		if err != nil {
+			return apiErrMsg{err}
+		}
+		return actionDoneMsg{fmt.Sprintf("Scaled %s à %d", depName, r)}
+	}
+}
+
 func (m Model) handleEnter() (tea.Model, tea.Cmd) {
 	switch m.view {
 	case ViewProjects:
 		items := m.filteredNamespaces()
 		if m.cursor < len(items) {
-			m.stopWatch()
-			m.client.SetNamespace(items[m.cursor].Name)
-			m.filter.SetValue("")
-			m.view = ViewPods
-			m.loading = true
-			return m, m.loadCurrentView()
+			return m.switchNamespace(items[m.cursor].Name)
 		}
 	case ViewPods:
 		items := m.filteredPods()
 		if m.cursor < len(items) {
 			pod := items[m.cursor]
+			m.targetRow(pod.Namespace)
 			if len(pod.Containers) > 1 {
-				// Multi-container: show selector
+				// Multi-container: show selector, init and ephemeral
+				// containers included
 				m.containerPodName = pod.Name
-				m.containerChoices = make([]string, len(pod.Containers))
+				m.containerChoices = containerNames(pod.Containers)
+				m.containerDetails = make([]string, len(pod.Containers))
 				for i, c := range pod.Containers {
-					m.containerChoices[i] = c.Name
+					m.containerDetails[i] = containerDetail(c)
 				}
-				m.containerCursor = 0
+				m.containerCursor = defaultLogContainer(pod)
 				m.containerSelector = true
 				m.containerSelectorAction = "logs"
 				return m, nil
 			}
 			return m.openLogsForContainer(pod.Name, "")
 		}
+	case ViewContexts:
+		return m.switchContext()
+	case ViewOverview:
+		return m.openOverviewRow()
+	case ViewDeployments:
+		items := m.filteredDeployments()
+		if m.cursor < len(items) {
+			dep := items[m.cursor]
+			m.targetRow(dep.Namespace)
+			if dep.Selector == "" {
+				m.toast = newToast(fmt.Sprintf("Pas de sélecteur de pods pour %s", dep.Name), toastError)
+				return m, scheduleToastClear()
+			}
+			return m.openAggregatedLogs(dep.Selector, "deploy/"+dep.Name)
+		}
 	}
 	return m, nil
 }
 
 func (m Model) handleDeletePod() (tea.Model, tea.Cmd) {
+	if targets := m.markedPods(); len(targets) > 0 {
+		return m.confirmBulk(bulkDelete, "Supprimer", "pod", targets)
+	}
 	items := m.filteredPods()
 	if m.cursor >= len(items) {
 		return m, nil
 	}
+	m.targetRow(items[m.cursor].Namespace)
 	podName := items[m.cursor].Name
-	isProd := config.IsProdNamespace(m.client.GetNamespace(), m.cfg.ProdPatterns)
+	isProd := config.IsProdNamespace(m.targetNamespace(), m.cfg.ProdPatterns)
 
-	m.confirm.activate("Supprimer pod", podName, m.client.GetNamespace(), isProd, func() tea.Msg {
-		err := m.client.DeletePod(context.Background(), podName)
+	m.confirm.activate("Supprimer pod", podName, m.targetNamespace(), isProd, func() tea.Msg {
+		err := m.actionClient().DeletePod(context.Background(), podName)
 		if err != nil {
 			return apiErrMsg{err}
 		}
@@ -600,11 +1046,16 @@ func (m Model) handleDeletePod() (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleScaleDelta(delta int32) (tea.Model, tea.Cmd) {
+	targets := m.markedDeployments(func(d domain.DeploymentInfo) int32 { return d.Replicas + delta })
+	if len(targets) > 0 {
+		return m.confirmBulk(bulkScale, "Scale", "deployment", targets)
+	}
 	items := m.filteredDeployments()
 	if m.cursor >= len(items) {
 		return m, nil
 	}
 	dep := items[m.cursor]
+	m.targetRow(dep.Namespace)
 	newReplicas := dep.Replicas + delta
 	if newReplicas < 0 {
 		newReplicas = 0
@@ -612,7 +1063,7 @@ func (m Model) handleScaleDelta(delta int32) (tea.Model, tea.Cmd) {
 	depName := dep.Name
 	m.loading = true
 	return m, func() tea.Msg {
-		err := m.client.ScaleDeployment(context.Background(), depName, newReplicas)
+		err := m.actionClient().ScaleDeployment(context.Background(), depName, newReplicas)
 		if err != nil {
 			return apiErrMsg{err}
 		}
@@ -625,20 +1076,55 @@ func (m Model) activateScaleInput() (tea.Model, tea.Cmd) {
 	if m.cursor >= len(items) {
 		return m, nil
 	}
+	m.targetRow(items[m.cursor].Namespace)
 	m.scalingDep = items[m.cursor].Name
+	m.scaleTargets = m.markedDeployments(nil)
 	m.scaleActive = true
 	m.scaleInput.SetValue("")
 	m.scaleInput.Focus()
 	return m, textinput.Blink
 }
 
+// handleRestartDeployment rolls out new pods for the marked deployments, or
+// the one under the cursor.
+func (m Model) handleRestartDeployment() (tea.Model, tea.Cmd) {
+	if targets := m.markedDeployments(nil); len(targets) > 0 {
+		return m.confirmBulk(bulkRestart, "Restart", "deployment", targets)
+	}
+	items := m.filteredDeployments()
+	if m.cursor >= len(items) {
+		return m, nil
+	}
+	m.targetRow(items[m.cursor].Namespace)
+	depName := items[m.cursor].Name
+	isProd := config.IsProdNamespace(m.targetNamespace(), m.cfg.ProdPatterns)
+
+	m.confirm.activate("Restart deployment", depName, m.targetNamespace(), isProd, func() tea.Msg {
+		err := m.actionClient().RestartDeployment(context.Background(), depName)
+		if err != nil {
+			return apiErrMsg{err}
+		}
+		return actionDoneMsg{fmt.Sprintf("Deployment '%s' redémarré", depName)}
+	})
+	return m, nil
+}
+
 func (m Model) openLogsForContainer(podName, containerName string) (Model, tea.Cmd) {
 	m.prevView = m.view
 	m.view = ViewLogs
 	m.loading = true
-	m.logState = logState{podName: podName, containerName: containerName, wrap: m.logState.wrap}
-	return m, func() tea.Msg {
-		content, err := m.client.GetPodLogs(context.Background(), podName, containerName, 200, false)
+	m.logState = logState{podName: podName, containerName: containerName, wrap: m.logState.wrap, tailLines: m.cfg.Logs.TailLines}
+	return m, m.fetchLogs()
+}
+
+// fetchLogs loads a snapshot of the current container log over the
+// selected range.
+func (m Model) fetchLogs() tea.Cmd {
+	podName, containerName := m.logState.podName, m.logState.containerName
+	opts := m.logState.rng.options(m.logState.tailLines, m.cfg.Logs)
+	opts.Previous = m.logState.previous
+	return func() tea.Msg {
+		content, err := m.actionClient().GetPodLogs(context.Background(), podName, containerName, opts)
 		if err != nil {
 			return apiErrMsg{err}
 		}
@@ -646,6 +1132,126 @@ func (m Model) openLogsForContainer(podName, containerName string) (Model, tea.C
 	}
 }
 
+// cycleLogRange switches to the next time range and reloads the view:
+// snapshot, live stream or aggregated streams alike.
+func (m Model) cycleLogRange() (tea.Model, tea.Cmd) {
+	if m.logState.previous {
+		m.toast = newToast("Période indisponible sur les logs précédents", toastError)
+		return m, scheduleToastClear()
+	}
+	m.logState.rng = (m.logState.rng + 1) % 4
+	if m.logState.aggregated() {
+		sel, _ := parseLabelSelector(m.logState.selector)
+		return m, m.startAggregation(sel)
+	}
+	if m.logState.following {
+		m.stopLogFollow()
+		return m.toggleFollowLogs()
+	}
+	m.loading = true
+	return m, m.fetchLogs()
+}
+
+// toggleFollowLogs switches the log view between the static snapshot and a
+// live stream. Following restarts from the selected range, like the snapshot.
+func (m Model) toggleFollowLogs() (tea.Model, tea.Cmd) {
+	if m.logState.following {
+		m.stopLogFollow()
+		return m, nil
+	}
+	if m.logState.aggregated() {
+		sel, _ := parseLabelSelector(m.logState.selector)
+		return m, m.startAggregation(sel)
+	}
+	if m.logState.previous {
+		m.toast = newToast("Follow indisponible sur les logs précédents", toastError)
+		return m, scheduleToastClear()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := m.actionClient().StreamPodLogs(ctx, m.logState.podName, m.logState.containerName, m.logState.rng.streamOptions(m.logState.tailLines, m.cfg.Logs))
+	if err != nil {
+		cancel()
+		m.toast = newToast(fmt.Sprintf("Follow: %v", err), toastError)
+		return m, scheduleToastClear()
+	}
+	m.logCancel = cancel
+	m.logCh = ch
+	m.logState.following = true
+	m.logState.paused = false
+	m.logState.lines = nil
+	m.logState.times = nil
+	m.logState.offset = 0
+	return m, listenLogs(ch)
+}
+
+// openAggregatedLogs opens the log view on every pod matching selector.
+func (m Model) openAggregatedLogs(selector, title string) (Model, tea.Cmd) {
+	sel, err := parseLabelSelector(selector)
+	if err != nil {
+		m.toast = newToast(err.Error(), toastError)
+		return m, scheduleToastClear()
+	}
+	m.prevView = m.view
+	m.view = ViewLogs
+	m.logState = logState{selector: selector, title: title, wrap: m.logState.wrap, tailLines: multiLogTailLines}
+	return m, m.startAggregation(sel)
+}
+
+// startAggregation (re)starts the merged stream of the aggregated log view.
+func (m *Model) startAggregation(sel labelSelector) tea.Cmd {
+	m.stopLogFollow()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := startLogAggregator(ctx, m.actionClient(), sel, m.logState.rng.streamOptions(m.logState.tailLines, m.cfg.Logs))
+	m.logCancel = cancel
+	m.logCh = ch
+	m.logState.following = true
+	m.logState.paused = false
+	m.logState.lines = nil
+	m.logState.sources = nil
+	m.logState.times = nil
+	m.logState.offset = 0
+	return listenLogs(ch)
+}
+
+// stopLogFollow cancels the log stream, keeping the lines received so far.
+func (m *Model) stopLogFollow() {
+	if m.logCancel != nil {
+		m.logCancel()
+		m.logCancel = nil
+	}
+	m.logCh = nil
+	if m.logState.following {
+		m.logState.following = false
+		m.logState.paused = false
+		m.logState.content = strings.Join(m.logState.lines, "\n")
+	}
+}
+
+// listenLogs waits for the next streamed line, then drains what is already
+// buffered so that bursts are rendered in a single update.
+func listenLogs(ch <-chan domain.LogLine) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-ch
+		if !ok {
+			return logStreamEndedMsg{ch: ch}
+		}
+		lines := []domain.LogLine{line}
+		for len(lines) < 500 {
+			select {
+			case line, ok := <-ch:
+				if !ok {
+					return logLinesMsg{ch: ch, lines: lines}
+				}
+				lines = append(lines, line)
+			default:
+				return logLinesMsg{ch: ch, lines: lines}
+			}
+		}
+		return logLinesMsg{ch: ch, lines: lines}
+	}
+}
+
 func (m Model) handleContainerSelector(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch {
 	case key.Matches(msg, keys.Escape):
@@ -663,24 +1269,33 @@ func (m Model) handleContainerSelector(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.containerSelectorAction == "exec" {
 			return m.startExec(m.containerPodName, containerName)
 		}
+		if m.containerSelectorAction == "run" {
+			return m.activateCommandInput(m.containerPodName, containerName)
+		}
+		if m.containerSelectorAction == "debug" {
+			return m.startDebug(m.containerPodName, containerName)
+		}
+		if m.containerSelectorAction == "download" {
+			return m, m.fileCopy.activate(copyDownload, m.containerPodName, containerName)
+		}
+		if m.containerSelectorAction == "upload" {
+			return m, m.fileCopy.activate(copyUpload, m.containerPodName, containerName)
+		}
 		return m.openLogsForContainer(m.containerPodName, containerName)
 	}
 	return m, nil
 }
 
 func (m Model) togglePreviousLogs() (tea.Model, tea.Cmd) {
+	if m.logState.aggregated() {
+		m.toast = newToast("Logs précédents indisponibles en vue agrégée", toastError)
+		return m, scheduleToastClear()
+	}
+	m.stopLogFollow()
 	newPrevious := !m.logState.previous
-	podName := m.logState.podName
-	containerName := m.logState.containerName
 	m.loading = true
 	m.logState.previous = newPrevious
-	return m, func() tea.Msg {
-		content, err := m.client.GetPodLogs(context.Background(), podName, containerName, 200, newPrevious)
-		if err != nil {
-			return apiErrMsg{err}
-		}
-		return logsLoadedMsg{content}
-	}
+	return m, m.fetchLogs()
 }
 
 func (m Model) handleExecPod() (tea.Model, tea.Cmd) {
@@ -689,19 +1304,18 @@ func (m Model) handleExecPod() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	pod := items[m.cursor]
+	m.targetRow(pod.Namespace)
 
 	// Check readonly namespace
-	if config.IsReadonlyNamespace(m.client.GetNamespace(), m.cfg.ReadonlyNamespaces) {
+	if config.IsReadonlyNamespace(m.targetNamespace(), m.cfg.ReadonlyNamespaces) {
 		m.toast = newToast("Namespace en lecture seule — exec interdit", toastError)
 		return m, scheduleToastClear()
 	}
 
-	if len(pod.Containers) > 1 {
+	if cs := appContainers(pod); len(cs) > 1 {
 		m.containerPodName = pod.Name
-		m.containerChoices = make([]string, len(pod.Containers))
-		for i, c := range pod.Containers {
-			m.containerChoices[i] = c.Name
-		}
+		m.containerChoices = containerNames(cs)
+		m.containerDetails = nil
 		m.containerCursor = 0
 		m.containerSelector = true
 		m.containerSelectorAction = "exec"
@@ -712,15 +1326,389 @@ func (m Model) handleExecPod() (tea.Model, tea.Cmd) {
 
 func (m Model) startExec(podName, containerName string) (Model, tea.Cmd) {
 	shell := m.cfg.Exec.Shell
-	ns := m.client.GetNamespace()
-	cmd, err := m.client.BuildExecCmd(ns, podName, containerName, shell)
+	ns := m.targetNamespace()
+	done := func(err error) tea.Msg {
+		return execDoneMsg{err: err}
+	}
+
+	// External oc/kubectl first, unless the built-in exec is forced.
+	// In auto mode a missing binary falls through to the built-in exec.
+	if m.cfg.Exec.Mode != config.ExecModeBuiltin {
+		cmd, err := m.actionClient().BuildExecCmd(ns, podName, containerName, shell)
+		if err == nil {
+			return m, tea.ExecProcess(cmd, done)
+		}
+		if m.cfg.Exec.Mode == config.ExecModeExternal || !errors.Is(err, domain.ErrNoExecTool) {
+			m.toast = newToast(fmt.Sprintf("Exec: %v", err), toastError)
+			return m, scheduleToastClear()
+		}
+	}
+
+	session, err := m.actionClient().NewExecSession(ns, podName, containerName, []string{shell})
 	if err != nil {
 		m.toast = newToast(fmt.Sprintf("Exec: %v", err), toastError)
 		return m, scheduleToastClear()
 	}
-	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
-		return execDoneMsg{err: err}
-	})
+	return m, tea.Exec(session, done)
+}
+
+func (m Model) handleDebugPod() (tea.Model, tea.Cmd) {
+	items := m.filteredPods()
+	if m.cursor >= len(items) {
+		return m, nil
+	}
+	pod := items[m.cursor]
+	m.targetRow(pod.Namespace)
+
+	if config.IsReadonlyNamespace(m.targetNamespace(), m.cfg.ReadonlyNamespaces) {
+		m.toast = newToast("Namespace en lecture seule — exec interdit", toastError)
+		return m, scheduleToastClear()
+	}
+
+	if cs := appContainers(pod); len(cs) > 1 {
+		m.containerPodName = pod.Name
+		m.containerChoices = containerNames(cs)
+		m.containerDetails = nil
+		m.containerCursor = 0
+		m.containerSelector = true
+		m.containerSelectorAction = "debug"
+		return m, nil
+	}
+	target := ""
+	if cs := appContainers(pod); len(cs) == 1 {
+		target = cs[0].Name
+	}
+	return m.startDebug(pod.Name, target)
+}
+
+// startDebug injects an ephemeral debug container targeting targetContainer,
+// then opens a shell in it. Injecting a container modifies the pod, so prod
+// namespaces require the full-name confirmation.
+func (m Model) startDebug(podName, targetContainer string) (Model, tea.Cmd) {
+	image := m.cfg.Exec.DebugImage
+	create := func() tea.Msg {
+		name, err := m.actionClient().CreateDebugContainer(context.Background(), podName, targetContainer, image)
+		if err != nil {
+			return apiErrMsg{err}
+		}
+		return debugReadyMsg{podName: podName, containerName: name}
+	}
+
+	ns := m.targetNamespace()
+	if config.IsProdNamespace(ns, m.cfg.ProdPatterns) {
+		m.confirm.activate(fmt.Sprintf("Debug (%s)", image), podName, ns, true, create)
+		return m, nil
+	}
+
+	m.loading = true
+	m.toast = newToast(fmt.Sprintf("Démarrage du conteneur de debug (%s)...", image), toastInfo)
+	return m, create
+}
+
+func (m Model) handleRunCommand() (tea.Model, tea.Cmd) {
+	items := m.filteredPods()
+	if m.cursor >= len(items) {
+		return m, nil
+	}
+	pod := items[m.cursor]
+	m.targetRow(pod.Namespace)
+
+	// Running a command is an exec: same readonly rule as the shell.
+	if config.IsReadonlyNamespace(m.targetNamespace(), m.cfg.ReadonlyNamespaces) {
+		m.toast = newToast("Namespace en lecture seule — exec interdit", toastError)
+		return m, scheduleToastClear()
+	}
+
+	if cs := appContainers(pod); len(cs) > 1 {
+		m.containerPodName = pod.Name
+		m.containerChoices = containerNames(cs)
+		m.containerDetails = nil
+		m.containerCursor = 0
+		m.containerSelector = true
+		m.containerSelectorAction = "run"
+		return m, nil
+	}
+	return m.activateCommandInput(pod.Name, "")
+}
+
+func (m Model) activateCommandInput(podName, containerName string) (Model, tea.Cmd) {
+	m.commandPodName = podName
+	m.commandContainer = containerName
+	m.commandActive = true
+	m.commandHistoryIdx = -1
+	m.commandInput.SetValue("")
+	m.commandInput.Focus()
+	return m, textinput.Blink
+}
+
+func (m Model) handleCommandInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	history := m.commandHistory[m.targetNamespace()]
+	switch msg.String() {
+	case "esc":
+		m.commandActive = false
+		m.commandInput.Blur()
+		m.commandInput.SetValue("")
+		return m, nil
+	case "up":
+		if m.commandHistoryIdx+1 < len(history) {
+			m.commandHistoryIdx++
+			m.commandInput.SetValue(history[m.commandHistoryIdx])
+			m.commandInput.CursorEnd()
+		}
+		return m, nil
+	case "down":
+		if m.commandHistoryIdx > 0 {
+			m.commandHistoryIdx--
+			m.commandInput.SetValue(history[m.commandHistoryIdx])
+			m.commandInput.CursorEnd()
+		} else {
+			m.commandHistoryIdx = -1
+			m.commandInput.SetValue("")
+		}
+		return m, nil
+	case "enter":
+		line := strings.TrimSpace(m.commandInput.Value())
+		if line == "" {
+			return m, nil
+		}
+		m.commandActive = false
+		m.commandInput.Blur()
+		ns := m.targetNamespace()
+		if m.commandHistory == nil {
+			m.commandHistory = make(map[string][]string)
+		}
+		m.commandHistory[ns] = pushCommandHistory(history, line)
+
+		m.prevView = m.view
+		m.view = ViewCommand
+		m.loading = true
+		m.commandState = commandState{
+			podName:       m.commandPodName,
+			containerName: m.commandContainer,
+			command:       line,
+		}
+		return m, m.runCommand()
+	default:
+		var cmd tea.Cmd
+		m.commandInput, cmd = m.commandInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// runCommand executes the command held in commandState.
+func (m Model) runCommand() tea.Cmd {
+	ns := m.targetNamespace()
+	podName := m.commandState.podName
+	containerName := m.commandState.containerName
+	argv := commandArgv(m.commandState.command, m.cfg.Exec.Shell)
+	return func() tea.Msg {
+		res, err := m.actionClient().RunCommand(context.Background(), ns, podName, containerName, argv)
+		if err != nil {
+			return apiErrMsg{err}
+		}
+		return commandDoneMsg{res}
+	}
+}
+
+func (m Model) handleLabelInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.labelActive = false
+		m.labelInput.Blur()
+		return m, nil
+	case "enter":
+		selector := strings.TrimSpace(m.labelInput.Value())
+		if selector == "" {
+			return m, nil
+		}
+		m.labelActive = false
+		m.labelInput.Blur()
+		return m.openAggregatedLogs(selector, selector)
+	default:
+		var cmd tea.Cmd
+		m.labelInput, cmd = m.labelInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// handleLogSearchInput edits the log search pattern. An empty pattern
+// clears the search; an invalid regex keeps the prompt open.
+func (m Model) handleLogSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.logSearching = false
+		m.logSearchInput.Blur()
+		return m, nil
+	case "enter":
+		pattern := m.logSearchInput.Value()
+		if pattern == "" {
+			m.logState.clearSearch()
+		} else {
+			re, err := compileLogSearch(pattern)
+			if err != nil {
+				m.toast = newToast(err.Error(), toastError)
+				return m, scheduleToastClear()
+			}
+			m.logState.setSearch(pattern, re, m.contentHeight())
+		}
+		m.logSearching = false
+		m.logSearchInput.Blur()
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.logSearchInput, cmd = m.logSearchInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// handleGrepInput edits the grep filter of the logs view. An empty input
+// removes the filter; the buffer is filtered locally, nothing is refetched.
+func (m Model) handleGrepInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.grepActive = false
+		m.grepInput.Blur()
+		return m, nil
+	case "enter":
+		var g *grepFilter
+		if strings.TrimSpace(m.grepInput.Value()) != "" {
+			var err error
+			if g, err = parseGrepFilter(m.grepInput.Value()); err != nil {
+				m.toast = newToast(err.Error(), toastError)
+				return m, scheduleToastClear()
+			}
+		}
+		m.logState.setGrep(g, m.contentHeight())
+		m.grepActive = false
+		m.grepInput.Blur()
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.grepInput, cmd = m.grepInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// handleFieldInput edits the JSON field filter of the logs view. An empty
+// input removes the filter.
+func (m Model) handleFieldInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.fieldActive = false
+		m.fieldInput.Blur()
+		return m, nil
+	case "enter":
+		var f *fieldFilter
+		if strings.TrimSpace(m.fieldInput.Value()) != "" {
+			var err error
+			if f, err = parseFieldFilter(m.fieldInput.Value()); err != nil {
+				m.toast = newToast(err.Error(), toastError)
+				return m, scheduleToastClear()
+			}
+		}
+		m.logState.setFieldFilter(f, m.contentHeight())
+		m.fieldActive = false
+		m.fieldInput.Blur()
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.fieldInput, cmd = m.fieldInput.Update(msg)
+		return m, cmd
+	}
+}
+
+func (m Model) handleCopyPod(direction copyDirection) (tea.Model, tea.Cmd) {
+	items := m.filteredPods()
+	if m.cursor >= len(items) {
+		return m, nil
+	}
+	pod := items[m.cursor]
+	m.targetRow(pod.Namespace)
+
+	if config.IsReadonlyNamespace(m.targetNamespace(), m.cfg.ReadonlyNamespaces) {
+		m.toast = newToast("Namespace en lecture seule — exec interdit", toastError)
+		return m, scheduleToastClear()
+	}
+	if m.fileCopy.running {
+		m.toast = newToast("Une copie est déjà en cours", toastError)
+		return m, scheduleToastClear()
+	}
+
+	if cs := appContainers(pod); len(cs) > 1 {
+		m.containerPodName = pod.Name
+		m.containerChoices = containerNames(cs)
+		m.containerDetails = nil
+		m.containerCursor = 0
+		m.containerSelector = true
+		m.containerSelectorAction = "download"
+		if direction == copyUpload {
+			m.containerSelectorAction = "upload"
+		}
+		return m, nil
+	}
+	return m, m.fileCopy.activate(direction, pod.Name, "")
+}
+
+func (m Model) handleCopyInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.fileCopy.cancel()
+		return m, nil
+	case "enter":
+		if !m.fileCopy.next() {
+			return m, nil
+		}
+		// Writing into a container changes it: prod requires the full-name confirmation.
+		ns := m.targetNamespace()
+		if m.fileCopy.direction == copyUpload && config.IsProdNamespace(ns, m.cfg.ProdPatterns) {
+			m.confirm.activate(fmt.Sprintf("Envoyer %s", m.fileCopy.source), m.fileCopy.podName, ns, true, func() tea.Msg {
+				return copyConfirmedMsg{}
+			})
+			return m, nil
+		}
+		return m.startCopy()
+	default:
+		var cmd tea.Cmd
+		m.fileCopy.input, cmd = m.fileCopy.input.Update(msg)
+		return m, cmd
+	}
+}
+
+// startCopy runs the copy described by fileCopy in the background and
+// streams its progress until copyDoneMsg.
+func (m Model) startCopy() (Model, tea.Cmd) {
+	ns := m.targetNamespace()
+	cs := m.fileCopy
+	ch := make(chan int64, 1)
+	m.fileCopy.running = true
+	m.fileCopy.bytes = 0
+	m.fileCopy.total = 0
+	m.fileCopy.progress = ch
+	if cs.direction == copyUpload {
+		m.fileCopy.total = localSize(cs.source)
+	}
+
+	run := func() tea.Msg {
+		var last int64
+		// Keep only the latest value so a slow UI never blocks the transfer.
+		progress := func(n int64) {
+			last = n
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- n
+		}
+		var err error
+		if cs.direction == copyDownload {
+			err = m.actionClient().CopyFromPod(context.Background(), ns, cs.podName, cs.containerName, cs.source, cs.dest, progress)
+		} else {
+			err = m.actionClient().CopyToPod(context.Background(), ns, cs.podName, cs.containerName, cs.source, cs.dest, progress)
+		}
+		close(ch)
+		return copyDoneMsg{direction: cs.direction, dest: cs.dest, bytes: last, err: err}
+	}
+	return m, tea.Batch(run, listenCopyProgress(ch))
 }
 
 func (m Model) handleYAML() (tea.Model, tea.Cmd) {
@@ -731,6 +1719,7 @@ func (m Model) handleYAML() (tea.Model, tea.Cmd) {
 		if m.cursor >= len(items) {
 			return m, nil
 		}
+		m.targetRow(items[m.cursor].Namespace)
 		resourceName = items[m.cursor].Name
 		resourceType = "pod"
 	case ViewDeployments:
@@ -738,6 +1727,7 @@ func (m Model) handleYAML() (tea.Model, tea.Cmd) {
 		if m.cursor >= len(items) {
 			return m, nil
 		}
+		m.targetRow(items[m.cursor].Namespace)
 		resourceName = items[m.cursor].Name
 		resourceType = "deployment"
 	default:
@@ -755,9 +1745,9 @@ func (m Model) handleYAML() (tea.Model, tea.Cmd) {
 		var content string
 		var err error
 		if rType == "pod" {
-			content, err = m.client.GetPodYAML(context.Background(), name)
+			content, err = m.actionClient().GetPodYAML(context.Background(), name)
 		} else {
-			content, err = m.client.GetDeploymentYAML(context.Background(), name)
+			content, err = m.actionClient().GetDeploymentYAML(context.Background(), name)
 		}
 		if err != nil {
 			return apiErrMsg{err}
@@ -766,6 +1756,41 @@ func (m Model) handleYAML() (tea.Model, tea.Cmd) {
 	}
 }
 
+// handleObjectEvents opens the Events view scoped to the selected pod or
+// deployment.
+func (m Model) handleObjectEvents() (tea.Model, tea.Cmd) {
+	var ref domain.ObjectRef
+	switch m.view {
+	case ViewPods:
+		items := m.filteredPods()
+		if m.cursor >= len(items) {
+			return m, nil
+		}
+		m.targetRow(items[m.cursor].Namespace)
+		ref = domain.ObjectRef{Kind: "Pod", Name: items[m.cursor].Name, UID: items[m.cursor].UID}
+	case ViewDeployments:
+		items := m.filteredDeployments()
+		if m.cursor >= len(items) {
+			return m, nil
+		}
+		m.targetRow(items[m.cursor].Namespace)
+		ref = domain.ObjectRef{Kind: "Deployment", Name: items[m.cursor].Name, UID: items[m.cursor].UID}
+	default:
+		return m, nil
+	}
+
+	m.stopWatch()
+	m.stopTimeline()
+	m.prevView = m.view
+	m.view = ViewEvents
+	m.eventScope = &ref
+	m.events = nil
+	m.cursor = 0
+	m.filter.SetValue("")
+	m.loading = true
+	return m, m.loadCurrentView()
+}
+
 func (m Model) cycleSort() (tea.Model, tea.Cmd) {
 	state := m.sortState[m.view]
 	switch m.view {
@@ -802,9 +1827,17 @@ func (m Model) copyPodName() (tea.Model, tea.Cmd) {
 func (m Model) switchView(v View) (tea.Model, tea.Cmd) {
 	if m.view == ViewLogs {
 		// from logs, go back first
+		m.stopLogFollow()
 		m.logState = logState{}
 	}
+	m.commandState = commandState{}
 	m.stopWatch()
+	m.stopTimeline()
+	m.eventScope = nil
+	m.marks = nil
+	if v == ViewEvents {
+		m.alerts.unseen = 0
+	}
 	m.view = v
 	m.cursor = 0
 	m.filter.SetValue("")
@@ -812,6 +1845,28 @@ func (m Model) switchView(v View) (tea.Model, tea.Cmd) {
 	return m, m.loadCurrentView()
 }
 
+// switchNamespace opens the pods of another namespace, leaving the
+// all-namespaces mode.
+func (m Model) switchNamespace(ns string) (tea.Model, tea.Cmd) {
+	m.client.SetAllNamespaces(false)
+	m.client.SetNamespace(ns)
+	// Alerts follow the namespace
+	m.alerts.stop()
+	m.alerts.unseen = 0
+	updated, cmd := m.switchView(ViewPods)
+	m = updated.(Model)
+	return m, tea.Batch(cmd, m.startAlerts())
+}
+
+// quit stops the watches and streams before leaving.
+func (m Model) quit() (tea.Model, tea.Cmd) {
+	m.stopWatch()
+	m.stopTimeline()
+	m.alerts.stop()
+	m.stopLogFollow()
+	return m, tea.Quit
+}
+
 // --- Error handling ---
 
 func (m Model) handleAPIError(err error) (tea.Model, tea.Cmd) {
@@ -864,7 +1919,13 @@ func (m Model) handleAPIError(err error) (tea.Model, tea.Cmd) {
 
 // --- Data loading ---
 
+// loadCurrentView loads the data of the view shown, tagged with the context
+// generation.
 func (m Model) loadCurrentView() tea.Cmd {
+	return m.inContext(m.loadView())
+}
+
+func (m Model) loadView() tea.Cmd {
 	switch m.view {
 	case ViewProjects:
 		return func() tea.Msg {
@@ -891,6 +1952,16 @@ func (m Model) loadCurrentView() tea.Cmd {
 			return deploymentsLoadedMsg{items}
 		}
 	case ViewEvents:
+		if m.eventScope != nil {
+			ref := *m.eventScope
+			return func() tea.Msg {
+				items, err := m.actionClient().ListObjectEvents(context.Background(), ref)
+				if err != nil {
+					return apiErrMsg{err}
+				}
+				return eventsLoadedMsg{items}
+			}
+		}
 		return func() tea.Msg {
 			items, err := m.client.ListEvents(context.Background())
 			if err != nil {
@@ -898,6 +1969,18 @@ func (m Model) loadCurrentView() tea.Cmd {
 			}
 			return eventsLoadedMsg{items}
 		}
+	case ViewCommand:
+		return m.runCommand()
+	case ViewContexts:
+		return func() tea.Msg {
+			items, err := m.client.ListContexts()
+			if err != nil {
+				return apiErrMsg{err}
+			}
+			return contextsLoadedMsg{items}
+		}
+	case ViewOverview:
+		return loadOverview(m.client)
 	}
 	return nil
 }
@@ -935,6 +2018,12 @@ func (m *Model) startWatch() tea.Cmd {
 		ch, err = m.client.WatchDeployments(ctx)
 		resource = "deployment"
 	case ViewEvents:
+		if m.eventScope != nil {
+			// Scoped events are refreshed with r; the namespace watch
+			// would mix in other objects
+			cancel()
+			return nil
+		}
 		ch, err = m.client.WatchEvents(ctx)
 		resource = "event"
 	default:
@@ -949,7 +2038,7 @@ func (m *Model) startWatch() tea.Cmd {
 
 	m.watching = true
 	m.watchCh = ch
-	return listenWatch(ch, resource)
+	return m.inContext(listenWatch(ch, resource))
 }
 
 func listenWatch(ch <-chan domain.WatchEvent, resource string) tea.Cmd {
@@ -973,14 +2062,14 @@ func (m *Model) mergePodEvent(evt domain.WatchEvent) {
 		m.pods = append(m.pods, *evt.Pod)
 	case domain.EventModified:
 		for i, p := range m.pods {
-			if p.Name == evt.Pod.Name {
+			if p.Name == evt.Pod.Name && p.Namespace == evt.Pod.Namespace {
 				m.pods[i] = *evt.Pod
 				break
 			}
 		}
 	case domain.EventDeleted:
 		for i, p := range m.pods {
-			if p.Name == evt.Pod.Name {
+			if p.Name == evt.Pod.Name && p.Namespace == evt.Pod.Namespace {
 				m.pods = append(m.pods[:i], m.pods[i+1:]...)
 				if m.cursor > 0 && m.cursor >= len(m.pods) {
 					m.cursor--
@@ -1000,14 +2089,14 @@ func (m *Model) mergeDeploymentEvent(evt domain.WatchEvent) {
 		m.deployments = append(m.deployments, *evt.Deployment)
 	case domain.EventModified:
 		for i, d := range m.deployments {
-			if d.Name == evt.Deployment.Name {
+			if d.Name == evt.Deployment.Name && d.Namespace == evt.Deployment.Namespace {
 				m.deployments[i] = *evt.Deployment
 				break
 			}
 		}
 	case domain.EventDeleted:
 		for i, d := range m.deployments {
-			if d.Name == evt.Deployment.Name {
+			if d.Name == evt.Deployment.Name && d.Namespace == evt.Deployment.Namespace {
 				m.deployments = append(m.deployments[:i], m.deployments[i+1:]...)
 				if m.cursor > 0 && m.cursor >= len(m.deployments) {
 					m.cursor--
@@ -1027,14 +2116,14 @@ func (m *Model) mergeEventEvent(evt domain.WatchEvent) {
 		m.events = append(m.events, *evt.Event)
 	case domain.EventModified:
 		for i, e := range m.events {
-			if e.Reason == evt.Event.Reason && e.Object == evt.Event.Object {
+			if e.Reason == evt.Event.Reason && e.Object == evt.Event.Object && e.Namespace == evt.Event.Namespace {
 				m.events[i] = *evt.Event
 				break
 			}
 		}
 	case domain.EventDeleted:
 		for i, e := range m.events {
-			if e.Reason == evt.Event.Reason && e.Object == evt.Event.Object {
+			if e.Reason == evt.Event.Reason && e.Object == evt.Event.Object && e.Namespace == evt.Event.Namespace {
 				m.events = append(m.events[:i], m.events[i+1:]...)
 				if m.cursor > 0 && m.cursor >= len(m.events) {
 					m.cursor--
@@ -1067,13 +2156,17 @@ func (m Model) filteredNamespaces() []domain.NamespaceInfo {
 
 func (m Model) filteredPods() []domain.PodInfo {
 	f := m.filterText()
+	allNS := m.allNamespaces()
 	var result []domain.PodInfo
 	if f == "" {
 		result = m.pods
 	} else {
 		for _, p := range m.pods {
 			if strings.Contains(strings.ToLower(p.Name), f) ||
-				strings.Contains(strings.ToLower(p.Status), f) {
+				strings.Contains(strings.ToLower(p.Status), f) ||
+				strings.Contains(strings.ToLower(p.Phase), f) ||
+				(allNS && (strings.Contains(strings.ToLower(p.Namespace), f) ||
+					strings.Contains(strings.ToLower(p.Node), f))) {
 				result = append(result, p)
 			}
 		}
@@ -1083,12 +2176,14 @@ func (m Model) filteredPods() []domain.PodInfo {
 
 func (m Model) filteredDeployments() []domain.DeploymentInfo {
 	f := m.filterText()
+	allNS := m.allNamespaces()
 	var result []domain.DeploymentInfo
 	if f == "" {
 		result = m.deployments
 	} else {
 		for _, d := range m.deployments {
-			if strings.Contains(strings.ToLower(d.Name), f) {
+			if strings.Contains(strings.ToLower(d.Name), f) ||
+				(allNS && strings.Contains(strings.ToLower(d.Namespace), f)) {
 				result = append(result, d)
 			}
 		}
@@ -1098,13 +2193,15 @@ func (m Model) filteredDeployments() []domain.DeploymentInfo {
 
 func (m Model) filteredEvents() []domain.EventInfo {
 	f := m.filterText()
+	allNS := m.allNamespaces()
 	var result []domain.EventInfo
 	if f == "" {
 		result = m.events
 	} else {
 		for _, e := range m.events {
 			if strings.Contains(strings.ToLower(e.Reason), f) ||
-				strings.Contains(strings.ToLower(e.Message), f) {
+				strings.Contains(strings.ToLower(e.Message), f) ||
+				(allNS && strings.Contains(strings.ToLower(e.Namespace), f)) {
 				result = append(result, e)
 			}
 		}
@@ -1121,7 +2218,14 @@ func (m Model) listLen() int {
 	case ViewDeployments:
 		return len(m.filteredDeployments())
 	case ViewEvents:
+		if m.timeline.active {
+			return len(m.timelineRows())
+		}
 		return len(m.filteredEvents())
+	case ViewContexts:
+		return len(m.filteredContexts())
+	case ViewOverview:
+		return len(m.overviewRows())
 	default:
 		return 0
 	}
@@ -1130,6 +2234,9 @@ func (m Model) listLen() int {
 func (m Model) contentHeight() int {
 	// header(1) + tabs(1) + blank(1) + col_header(1) + status_bar(1) = 5 lines overhead
 	ch := m.height - 6
+	if m.compact() {
+		ch++ // no tab bar
+	}
 	if ch < 1 {
 		return 1
 	}
@@ -1154,9 +2261,11 @@ func (m Model) View() string {
 	b.WriteString(m.renderContextBar())
 	b.WriteString("\n")
 
-	// Tabs
-	b.WriteString(m.renderTabs())
-	b.WriteString("\n")
+	// Tabs, hidden on short terminals
+	if !m.compact() {
+		b.WriteString(m.renderTabs())
+		b.WriteString("\n")
+	}
 
 	// Disconnected banner
 	if m.disconnected {
@@ -1165,13 +2274,27 @@ func (m Model) View() string {
 		b.WriteString("\n")
 	}
 
-	// Confirm dialog / Container selector
-	if m.confirm.isActive() {
+	// Help overlay / Confirm dialog / Container selector
+	if m.help.active {
+		b.WriteString(renderHelp(m.helpLines(), m.view, m.help.offset, m.contentHeight()))
+	} else if m.confirm.isActive() {
 		b.WriteString(m.confirm.view(m.width))
 	} else if m.containerSelector {
-		b.WriteString(renderContainerSelector(m.containerPodName, m.containerChoices, m.containerCursor))
+		b.WriteString(renderContainerSelector(m.containerPodName, m.containerChoices, m.containerDetails, m.containerCursor))
+	} else if m.scaleActive && len(m.scaleTargets) > 0 {
+		b.WriteString(fmt.Sprintf("\n  Scale %d deployments marqués - Replicas: %s\n", len(m.scaleTargets), m.scaleInput.View()))
 	} else if m.scaleActive {
 		b.WriteString(fmt.Sprintf("\n  Scale %s - Replicas: %s\n", m.scalingDep, m.scaleInput.View()))
+	} else if m.commandActive {
+		target := m.commandPodName
+		if m.commandContainer != "" {
+			target += "/" + m.commandContainer
+		}
+		b.WriteString(renderCommandPrompt(target, m.commandInput.View(), m.commandHistory[m.targetNamespace()], m.commandHistoryIdx))
+	} else if m.labelActive {
+		b.WriteString(fmt.Sprintf("\n  Logs agrégés — sélecteur de labels : %s\n\n  enter:suivre  esc:annuler\n", m.labelInput.View()))
+	} else if m.fileCopy.isActive() {
+		b.WriteString(renderCopyPrompt(&m.fileCopy))
 	} else if m.loading {
 		b.WriteString("\n  Chargement...\n")
 	} else {
@@ -1179,12 +2302,45 @@ func (m Model) View() string {
 		b.WriteString(m.renderContent())
 	}
 
+	// Copy progress
+	if m.fileCopy.running {
+		b.WriteString(renderCopyProgress(&m.fileCopy, m.width))
+	}
+
+	// Bulk action progress
+	if m.bulk.running() {
+		b.WriteString(renderBulkProgress(&m.bulk, m.width))
+	}
+
 	// Filter bar
 	if m.filtering {
 		b.WriteString(fmt.Sprintf("  /%s", m.filter.View()))
 		b.WriteString("\n")
 	}
 
+	// Command prompt
+	if m.palette.active {
+		b.WriteString(renderPalette(&m.palette))
+	}
+
+	// Log search bar
+	if m.logSearching {
+		b.WriteString(fmt.Sprintf("  Chercher /%s", m.logSearchInput.View()))
+		b.WriteString("\n")
+	}
+
+	// Log grep bar
+	if m.grepActive {
+		b.WriteString(fmt.Sprintf("  Grep (-A/-B/-C n) &%s", m.grepInput.View()))
+		b.WriteString("\n")
+	}
+
+	// Log JSON field filter bar
+	if m.fieldActive {
+		b.WriteString(fmt.Sprintf("  Champ JSON (champ=valeur) %s", m.fieldInput.View()))
+		b.WriteString("\n")
+	}
+
 	// Fill remaining space
 	lines := strings.Count(b.String(), "\n")
 	for i := lines; i < m.height-2; i++ {
@@ -1209,7 +2365,7 @@ func (m Model) renderContextBar() string {
 		return title
 	}
 	ctx := contextStyle.Render(m.client.GetContext())
-	ns := namespaceStyle.Render(m.client.GetNamespace())
+	ns := namespaceStyle.Render(m.namespaceLabel())
 	return fmt.Sprintf(" %s  ctx:%s  ns:%s", title, ctx, ns)
 }
 
@@ -1219,16 +2375,21 @@ func (m Model) renderTabs() string {
 		key   string
 		label string
 	}{
+		{ViewOverview, "0", "Overview"},
 		{ViewProjects, "1", "Projects"},
 		{ViewPods, "2", "Pods"},
 		{ViewDeployments, "3", "Deploys"},
 		{ViewEvents, "4", "Events"},
+		{ViewContexts, "5", "Contexts"},
 	}
 
 	var parts []string
 	for _, t := range tabs {
 		label := fmt.Sprintf("[%s] %s", t.key, t.label)
-		if m.view == t.view || (m.view == ViewLogs && m.prevView == t.view) {
+		if t.view == ViewEvents {
+			label += m.alerts.badge()
+		}
+		if m.view == t.view || ((m.view == ViewLogs || m.view == ViewCommand) && m.prevView == t.view) {
 			parts = append(parts, tabActiveStyle.Render(label))
 		} else {
 			parts = append(parts, tabInactiveStyle.Render(label))
@@ -1243,15 +2404,35 @@ func (m Model) renderContent() string {
 	case ViewProjects:
 		return renderProjectList(m.filteredNamespaces(), m.cursor, m.width, ch, m.client.GetNamespace())
 	case ViewPods:
-		return renderPodList(m.filteredPods(), m.cursor, m.width, ch)
+		return renderPodList(m.filteredPods(), m.cursor, m.width, ch, m.allNamespaces(), m.columnsConfig().Pods,
+			m.rowMarks())
 	case ViewDeployments:
-		return renderDeploymentList(m.filteredDeployments(), m.cursor, m.width, ch)
+		return renderDeploymentList(m.filteredDeployments(), m.cursor, m.width, ch, m.allNamespaces(),
+			m.columnsConfig().Deployments, m.rowMarks())
 	case ViewEvents:
-		return renderEventList(m.filteredEvents(), m.cursor, m.width, ch)
+		scope := ""
+		if m.eventScope != nil {
+			scope = fmt.Sprintf("  Events de %s\n", namespaceStyle.Render(m.eventScope.String()))
+			ch--
+			if m.listLen() == 0 {
+				return scope + fmt.Sprintf("  Aucun event pour %s\n", m.eventScope)
+			}
+		}
+		if m.timeline.active {
+			return scope + renderTimeline(m.timelineRows(), m.cursor, m.width, ch)
+		}
+		return scope + renderEventList(m.filteredEvents(), m.cursor, m.width, ch, m.allNamespaces(),
+			m.columnsConfig().Events)
 	case ViewLogs:
 		return renderLogs(&m.logState, m.width, ch)
 	case ViewYAML:
 		return renderYAMLView(&m.yamlState, m.width, ch)
+	case ViewCommand:
+		return renderCommandOutput(&m.commandState, m.width, ch)
+	case ViewContexts:
+		return renderContextList(m.filteredContexts(), m.cursor, m.width, ch)
+	case ViewOverview:
+		return renderOverview(m.overviewRows(), m.cursor, m.width, ch)
 	default:
 		return ""
 	}
@@ -1267,30 +2448,45 @@ func (m Model) renderStatusBar() string {
 	case ViewDeployments:
 		helpText = deploymentHelpKeys()
 	case ViewEvents:
-		helpText = eventHelpKeys()
+		helpText = eventHelpKeys(m.timeline.active, m.eventScope != nil)
 	case ViewLogs:
-		helpText = logHelpKeys(m.logState.previous, m.logState.wrap)
+		helpText = logHelpKeys(m.logState.previous, m.logState.wrap, m.logState.following)
 	case ViewYAML:
 		helpText = yamlHelpKeys()
+	case ViewCommand:
+		helpText = commandHelpKeys()
+	case ViewContexts:
+		helpText = contextHelpKeys()
+	case ViewOverview:
+		helpText = overviewHelpKeys()
+	}
+	if m.help.active {
+		helpText = helpHelpKeys()
 	}
 
 	nsInfo := ""
 	if m.client != nil {
-		nsInfo = m.client.GetNamespace()
+		nsInfo = m.namespaceLabel()
 	}
 
 	liveIndicator := ""
 	if m.watching {
 		liveIndicator = liveStyle.Render(" ● LIVE")
 	}
+	liveIndicator += m.alerts.status()
 	var itemInfo string
 	switch m.view {
 	case ViewLogs:
 		itemInfo = fmt.Sprintf("%d lignes", len(m.logState.lines))
 	case ViewYAML:
 		itemInfo = fmt.Sprintf("%d lignes", len(m.yamlState.lines))
+	case ViewCommand:
+		itemInfo = fmt.Sprintf("%d lignes", len(m.commandState.lines))
 	default:
 		itemInfo = fmt.Sprintf("%d items", m.listLen())
+		if n := m.markedCount(); n > 0 {
+			itemInfo += fmt.Sprintf(", %d marqués", n)
+		}
 	}
 	left := fmt.Sprintf(" %s | %s | %s%s", m.view.String(), nsInfo, itemInfo, liveIndicator)
 	bar := statusBarStyle.Width(m.width).Render(left + "  " + helpText)
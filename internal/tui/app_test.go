@@ -208,11 +208,11 @@ func TestContentHeight(t *testing.T) {
 		t.Errorf("contentHeight() = %d, want 24 (30 - 6)", ch)
 	}
 
-	// Small terminal
+	// Small terminal: compact mode hides the tab bar
 	m.height = 10
 	ch = m.contentHeight()
-	if ch != 4 {
-		t.Errorf("contentHeight() = %d, want 4", ch)
+	if ch != 5 {
+		t.Errorf("contentHeight() = %d, want 5", ch)
 	}
 
 	// Very small terminal - should clamp to minimum 1
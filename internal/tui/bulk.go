@@ -0,0 +1,390 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// bulkWorkers bounds the actions of a bulk operation running at the same
+// time.
+const bulkWorkers = 4
+
+// bulkTarget is a row a bulk action applies to.
+type bulkTarget struct {
+	key       string // row key, see rowKey
+	namespace string
+	name      string
+	replicas  int32 // scale: the new replica count
+	client    domain.KubeGateway
+}
+
+// label is the target as listed in the confirmation.
+func (t bulkTarget) label(kind bulkKind, allNS bool) string {
+	s := t.name
+	if allNS {
+		s = t.namespace + "/" + t.name
+	}
+	if kind == bulkScale {
+		s += fmt.Sprintf(" → %d", t.replicas)
+	}
+	return s
+}
+
+type bulkKind int
+
+const (
+	bulkDelete bulkKind = iota
+	bulkRestart
+	bulkScale
+)
+
+// progress names the running action in the progress line and the summary.
+func (k bulkKind) progress() string {
+	switch k {
+	case bulkDelete:
+		return "Suppression"
+	case bulkRestart:
+		return "Restart"
+	default:
+		return "Scale"
+	}
+}
+
+// run applies the action to one target.
+func (k bulkKind) run(ctx context.Context, t bulkTarget) error {
+	switch k {
+	case bulkDelete:
+		return t.client.DeletePod(ctx, t.name)
+	case bulkRestart:
+		return t.client.RestartDeployment(ctx, t.name)
+	default:
+		return t.client.ScaleDeployment(ctx, t.name, t.replicas)
+	}
+}
+
+type bulkStatus int
+
+const (
+	bulkPending bulkStatus = iota
+	bulkSucceeded
+	bulkFailed
+)
+
+type bulkResult struct {
+	key  string
+	name string
+	err  error
+}
+
+// bulkStartedMsg, bulkResultMsg and bulkDoneMsg carry the channel of the
+// action so that results of a previous one are ignored.
+type bulkStartedMsg struct {
+	kind bulkKind
+	keys []string
+	ch   <-chan bulkResult
+}
+type bulkResultMsg struct {
+	ch     <-chan bulkResult
+	result bulkResult
+}
+type bulkDoneMsg struct{ ch <-chan bulkResult }
+
+// bulkState is the progress of the running bulk action, shown on its rows
+// and below the list.
+type bulkState struct {
+	kind     bulkKind
+	view     View
+	status   map[string]bulkStatus // by row key
+	total    int
+	done     int
+	failures []string // "name : error"
+	failed   []string // row keys, marked again when the action ends
+	ch       <-chan bulkResult
+}
+
+func (b *bulkState) running() bool { return b.ch != nil }
+
+// rowKey identifies a row of the pods or deployments view across refreshes.
+func rowKey(namespace, name string) string { return namespace + "/" + name }
+
+// rowKeys lists the keys of the visible rows of the current view.
+func (m Model) rowKeys() []string {
+	var keys []string
+	switch m.view {
+	case ViewPods:
+		for _, p := range m.filteredPods() {
+			keys = append(keys, rowKey(p.Namespace, p.Name))
+		}
+	case ViewDeployments:
+		for _, d := range m.filteredDeployments() {
+			keys = append(keys, rowKey(d.Namespace, d.Name))
+		}
+	}
+	return keys
+}
+
+// toggleMark marks or unmarks the row under the cursor, then moves down so
+// that a run of rows is marked by holding the key.
+func (m Model) toggleMark() (tea.Model, tea.Cmd) {
+	rows := m.rowKeys()
+	if m.cursor >= len(rows) {
+		return m, nil
+	}
+	m.setMark(rows[m.cursor], !m.marks[rows[m.cursor]])
+	m.cursor = min(m.cursor+1, len(rows)-1)
+	return m, nil
+}
+
+// markAll marks every row the filter shows.
+func (m Model) markAll() (tea.Model, tea.Cmd) {
+	for _, k := range m.rowKeys() {
+		m.setMark(k, true)
+	}
+	return m, nil
+}
+
+// invertMarks swaps marked and unmarked rows among those the filter shows.
+func (m Model) invertMarks() (tea.Model, tea.Cmd) {
+	for _, k := range m.rowKeys() {
+		m.setMark(k, !m.marks[k])
+	}
+	return m, nil
+}
+
+func (m *Model) setMark(k string, on bool) {
+	if m.marks == nil {
+		m.marks = make(map[string]bool)
+	}
+	if on {
+		m.marks[k] = true
+	} else {
+		delete(m.marks, k)
+	}
+}
+
+// markedCount counts the marked rows the filter shows. Rows hidden by the
+// filter keep their mark but are left out of bulk actions.
+func (m Model) markedCount() int {
+	n := 0
+	for _, k := range m.rowKeys() {
+		if m.marks[k] {
+			n++
+		}
+	}
+	return n
+}
+
+// newBulkTarget binds a row to the gateway its action goes through.
+// Gateways are created here: InNamespace is not safe for concurrent use.
+func (m Model) newBulkTarget(namespace, name string) bulkTarget {
+	t := bulkTarget{key: rowKey(namespace, name), namespace: m.client.GetNamespace(), name: name, client: m.client}
+	if m.allNamespaces() {
+		t.namespace = namespace
+		t.client = m.client.InNamespace(namespace)
+	}
+	return t
+}
+
+// markedPods lists the marked pods the filter shows.
+func (m Model) markedPods() []bulkTarget {
+	var targets []bulkTarget
+	for _, p := range m.filteredPods() {
+		if m.marks[rowKey(p.Namespace, p.Name)] {
+			targets = append(targets, m.newBulkTarget(p.Namespace, p.Name))
+		}
+	}
+	return targets
+}
+
+// markedDeployments lists the marked deployments the filter shows, with the
+// replicas scale computes for each of them.
+func (m Model) markedDeployments(scale func(domain.DeploymentInfo) int32) []bulkTarget {
+	var targets []bulkTarget
+	for _, d := range m.filteredDeployments() {
+		if m.marks[rowKey(d.Namespace, d.Name)] {
+			t := m.newBulkTarget(d.Namespace, d.Name)
+			if scale != nil {
+				t.replicas = max(scale(d), 0)
+			}
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// confirmBulk asks once for the action on all the marked targets, as a
+// production confirmation when one of them is in a production namespace.
+func (m Model) confirmBulk(kind bulkKind, action, noun string, targets []bulkTarget) (tea.Model, tea.Cmd) {
+	if m.bulk.running() {
+		m.toast = newToast("Une action groupée est déjà en cours", toastError)
+		return m, scheduleToastClear()
+	}
+	isProd := false
+	var namespaces, labels []string
+	for _, t := range targets {
+		isProd = isProd || config.IsProdNamespace(t.namespace, m.cfg.ProdPatterns)
+		if !slices.Contains(namespaces, t.namespace) {
+			namespaces = append(namespaces, t.namespace)
+		}
+		labels = append(labels, t.label(kind, m.allNamespaces()))
+	}
+	if len(targets) > 1 {
+		noun += "s"
+	}
+	m.confirm.activateBulk(action, fmt.Sprintf("%d %s", len(targets), noun), labels,
+		strings.Join(namespaces, ", "), isProd, func() tea.Msg { return startBulk(kind, targets) })
+	return m, nil
+}
+
+// startBulk runs the action on the targets, bulkWorkers at a time. Results
+// arrive on the channel as each target is done; it is closed at the end.
+func startBulk(kind bulkKind, targets []bulkTarget) tea.Msg {
+	ch := make(chan bulkResult, len(targets))
+	keys := make([]string, len(targets))
+	for i, t := range targets {
+		keys[i] = t.key
+	}
+	go func() {
+		defer close(ch)
+		runBulk(targets, bulkWorkers, func(t bulkTarget) error {
+			return kind.run(context.Background(), t)
+		}, ch)
+	}()
+	return bulkStartedMsg{kind: kind, keys: keys, ch: ch}
+}
+
+// runBulk calls do on every target with at most workers calls at a time.
+func runBulk(targets []bulkTarget, workers int, do func(bulkTarget) error, out chan<- bulkResult) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, workers)
+	for _, t := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			out <- bulkResult{key: t.key, name: t.name, err: do(t)}
+		}()
+	}
+	wg.Wait()
+}
+
+func listenBulk(ch <-chan bulkResult) tea.Cmd {
+	return func() tea.Msg {
+		r, ok := <-ch
+		if !ok {
+			return bulkDoneMsg{ch}
+		}
+		return bulkResultMsg{ch: ch, result: r}
+	}
+}
+
+func (m Model) handleBulkStarted(msg bulkStartedMsg) (tea.Model, tea.Cmd) {
+	m.bulk = bulkState{
+		kind:   msg.kind,
+		view:   m.view,
+		status: make(map[string]bulkStatus, len(msg.keys)),
+		total:  len(msg.keys),
+		ch:     msg.ch,
+	}
+	for _, k := range msg.keys {
+		m.bulk.status[k] = bulkPending
+	}
+	m.marks = nil
+	return m, listenBulk(msg.ch)
+}
+
+func (m Model) handleBulkResult(msg bulkResultMsg) (tea.Model, tea.Cmd) {
+	if msg.ch != m.bulk.ch || m.bulk.ch == nil {
+		return m, nil
+	}
+	m.bulk.done++
+	if msg.result.err != nil {
+		m.bulk.status[msg.result.key] = bulkFailed
+		m.bulk.failures = append(m.bulk.failures, fmt.Sprintf("%s : %v", msg.result.name, msg.result.err))
+		m.bulk.failed = append(m.bulk.failed, msg.result.key)
+	} else {
+		m.bulk.status[msg.result.key] = bulkSucceeded
+	}
+	return m, listenBulk(m.bulk.ch)
+}
+
+// handleBulkDone sums up the action in a toast. Failed targets stay marked
+// so that the action can be run again on them.
+func (m Model) handleBulkDone(msg bulkDoneMsg) (tea.Model, tea.Cmd) {
+	if msg.ch != m.bulk.ch || m.bulk.ch == nil {
+		return m, nil
+	}
+	b := m.bulk
+	m.bulk = bulkState{}
+	ok := b.total - len(b.failures)
+	if len(b.failures) == 0 {
+		m.toast = newToast(fmt.Sprintf("%s : %d/%d réussis", b.kind.progress(), ok, b.total), toastSuccess)
+	} else {
+		m.toast = newToast(fmt.Sprintf("%s : %d/%d réussis, %d en échec, toujours marqués (%s)",
+			b.kind.progress(), ok, b.total, len(b.failures), b.failures[0]), toastError)
+		if m.view == b.view {
+			for _, k := range b.failed {
+				m.setMark(k, true)
+			}
+		}
+	}
+	return m, tea.Batch(scheduleToastClear(), m.loadCurrentView())
+}
+
+// rowMarks gives the prefix glyph of the marked rows and of the rows of the
+// running bulk action.
+func (m Model) rowMarks() map[string]string {
+	if len(m.marks) == 0 && (!m.bulk.running() || m.bulk.view != m.view) {
+		return nil
+	}
+	marks := make(map[string]string, len(m.marks)+len(m.bulk.status))
+	for k := range m.marks {
+		marks[k] = lipgloss.NewStyle().Foreground(colorPrimary).Render("●")
+	}
+	if m.bulk.view == m.view {
+		for k, s := range m.bulk.status {
+			marks[k] = bulkGlyph(s)
+		}
+	}
+	return marks
+}
+
+func bulkGlyph(s bulkStatus) string {
+	switch s {
+	case bulkSucceeded:
+		return lipgloss.NewStyle().Foreground(colorSuccess).Render("✓")
+	case bulkFailed:
+		return lipgloss.NewStyle().Foreground(colorError).Render("✗")
+	default:
+		return lipgloss.NewStyle().Foreground(colorWarning).Render("…")
+	}
+}
+
+// rowPrefix is the two-cell prefix of a list row: its glyph, if any.
+func rowPrefix(marks map[string]string, namespace, name string) string {
+	if g, ok := marks[rowKey(namespace, name)]; ok {
+		return g + " "
+	}
+	return "  "
+}
+
+func renderBulkProgress(b *bulkState, width int) string {
+	barWidth := 20
+	filled := b.done * barWidth / max(b.total, 1)
+	line := fmt.Sprintf("  %s  %d/%d  [%s%s]", b.kind.progress(), b.done, b.total,
+		strings.Repeat("█", filled), strings.Repeat("░", barWidth-filled))
+	if n := len(b.failures); n > 0 {
+		line += fmt.Sprintf("  %d en échec", n)
+	}
+	return truncate(line, width) + "\n"
+}
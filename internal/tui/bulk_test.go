@@ -0,0 +1,244 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+var spaceKey = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+
+func newBulkTestModel(view View) Model {
+	m := newTestModel()
+	m.view = view
+	m.pods = mockOf(m).Pods
+	m.deployments = mockOf(m).Deployments
+	return m
+}
+
+// runBulkAction feeds the messages of a started bulk action back to the
+// model until it is done.
+func runBulkAction(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	for i := 0; cmd != nil && i < 100; i++ {
+		msg := cmd()
+		updated, next := m.Update(msg)
+		m, cmd = updated.(Model), next
+		if _, ok := msg.(bulkDoneMsg); ok {
+			return m
+		}
+	}
+	t.Fatal("bulk action did not finish")
+	return m
+}
+
+func TestMarks_ToggleAllInvert(t *testing.T) {
+	m := newBulkTestModel(ViewPods)
+
+	updated, _ := m.Update(spaceKey)
+	m = updated.(Model)
+	if !m.marks[rowKey("", "api-pod-1")] || m.cursor != 1 {
+		t.Fatalf("space should mark the row and move down: marks %v, cursor %d", m.marks, m.cursor)
+	}
+	if !strings.Contains(m.View(), "1 marqués") {
+		t.Error("the status bar should count the marked rows")
+	}
+
+	m, _ = pressKey(m, "i")
+	if m.markedCount() != 2 || m.marks[rowKey("", "api-pod-1")] {
+		t.Errorf("invert should mark the two other pods, got %v", m.marks)
+	}
+
+	// Select all only marks the rows the filter shows
+	m.marks = nil
+	m.filter.SetValue("pod-2")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	m = updated.(Model)
+	if len(m.marks) != 1 || !m.marks[rowKey("", "worker-pod-2")] {
+		t.Errorf("marks = %v, want the filtered pod only", m.marks)
+	}
+
+	m.filter.SetValue("")
+	m, _ = pressKey(m, "esc")
+	if len(m.marks) != 0 {
+		t.Error("esc should clear the marks")
+	}
+
+	m, _ = pressKey(m, " ")
+	updated, _ = m.switchView(ViewDeployments)
+	if len(updated.(Model).marks) != 0 {
+		t.Error("marks should not follow to another view")
+	}
+}
+
+func TestBulkDelete_SingleConfirmation(t *testing.T) {
+	m := newBulkTestModel(ViewPods)
+	m = markAllRows(m)
+
+	m, _ = pressKey(m, "d")
+	if !m.confirm.isActive() || m.confirm.resourceName != "3 pods" {
+		t.Fatalf("confirm = %+v, want one confirmation for 3 pods", m.confirm)
+	}
+	view := m.View()
+	for _, name := range []string{"api-pod-1", "worker-pod-2", "redis-pod-3"} {
+		if !strings.Contains(view, name) {
+			t.Errorf("the confirmation should list %s:\n%s", name, view)
+		}
+	}
+
+	m, cmd := pressKey(m, "y")
+	m = runBulkAction(t, m, cmd)
+	if got := mockOf(m).DeletedPods; len(got) != 3 {
+		t.Errorf("deleted %v, want the 3 pods", got)
+	}
+	if !strings.Contains(m.toast.message, "3/3 réussis") || m.toast.level != toastSuccess {
+		t.Errorf("toast = %q, want the summary", m.toast.message)
+	}
+	if m.bulk.running() || len(m.marks) != 0 {
+		t.Error("the action should be over and the marks cleared")
+	}
+}
+
+func TestBulkDelete_FailuresStayMarked(t *testing.T) {
+	m := newBulkTestModel(ViewPods)
+	mockOf(m).ActionErrs = map[string]error{"worker-pod-2": errors.New("accès refusé")}
+	m = markAllRows(m)
+
+	m, _ = pressKey(m, "d")
+	m, cmd := pressKey(m, "y")
+
+	// Per-item progress while running
+	started, cmd := m.Update(cmd())
+	m = started.(Model)
+	if !strings.Contains(m.View(), "Suppression  0/3") {
+		t.Errorf("the progress line should show:\n%s", m.View())
+	}
+	m = runBulkAction(t, m, cmd)
+
+	if m.toast.level != toastError || !strings.Contains(m.toast.message, "2/3 réussis") ||
+		!strings.Contains(m.toast.message, "worker-pod-2 : accès refusé") {
+		t.Errorf("toast = %q, want the failure in the summary", m.toast.message)
+	}
+	if len(m.marks) != 1 || !m.marks[rowKey("", "worker-pod-2")] {
+		t.Errorf("marks = %v, want the failed pod marked again", m.marks)
+	}
+}
+
+func TestBulkDelete_ProdConfirmation(t *testing.T) {
+	m := newBulkTestModel(ViewPods)
+	m.client.SetAllNamespaces(true)
+	m.pods = []domain.PodInfo{
+		{Namespace: "team-dev", Name: "web-1"},
+		{Namespace: "shop-prod", Name: "web-2"},
+	}
+	m = markAllRows(m)
+
+	m, _ = pressKey(m, "d")
+	if m.confirm.mode != confirmProd {
+		t.Fatal("one target in a prod namespace should ask for the prod confirmation")
+	}
+	view := m.View()
+	if !strings.Contains(view, "team-dev/web-1") || !strings.Contains(view, "shop-prod/web-2") {
+		t.Errorf("the prod confirmation should list the targets:\n%s", view)
+	}
+
+	m = typeText(m, "2 pod")
+	m, cmd := pressKey(m, "enter")
+	if cmd != nil || !m.confirm.isActive() {
+		t.Fatal("a wrong confirmation text should not run the action")
+	}
+	m = typeText(m, "s")
+	m, cmd = pressKey(m, "enter")
+	m = runBulkAction(t, m, cmd)
+	if got := mockOf(m).DeletedPods; len(got) != 2 {
+		t.Errorf("deleted %v, want both pods", got)
+	}
+}
+
+func TestBulkScaleAndRestart(t *testing.T) {
+	m := newBulkTestModel(ViewDeployments)
+	m = markAllRows(m)
+
+	m, _ = pressKey(m, "s")
+	if !strings.Contains(m.View(), "Scale 2 deployments marqués") {
+		t.Errorf("the scale prompt should name the marked deployments:\n%s", m.View())
+	}
+	m = typeText(m, "4")
+	m, _ = pressKey(m, "enter")
+	if !strings.Contains(m.View(), "worker-deploy → 4") {
+		t.Errorf("the confirmation should show the new replicas:\n%s", m.View())
+	}
+	m, cmd := pressKey(m, "y")
+	m = runBulkAction(t, m, cmd)
+	if got := mockOf(m).ScaledDeps; got["api-deploy"] != 4 || got["worker-deploy"] != 4 {
+		t.Errorf("scaled %v, want both at 4", got)
+	}
+
+	m = markAllRows(m)
+	m, _ = pressKey(m, "-")
+	m, cmd = pressKey(m, "y")
+	m = runBulkAction(t, m, cmd)
+	if got := mockOf(m).ScaledDeps; got["api-deploy"] != 2 || got["worker-deploy"] != 1 {
+		t.Errorf("scaled %v, want each one down by one", got)
+	}
+
+	m = markAllRows(m)
+	m, _ = pressKey(m, "R")
+	m, cmd = pressKey(m, "y")
+	m = runBulkAction(t, m, cmd)
+	if got := mockOf(m).RestartedDeps; len(got) != 2 {
+		t.Errorf("restarted %v, want both deployments", got)
+	}
+}
+
+func TestRestartDeployment_CursorRow(t *testing.T) {
+	m := newBulkTestModel(ViewDeployments)
+	m, _ = pressKey(m, "R")
+	if m.confirm.resourceName != "api-deploy" {
+		t.Fatalf("confirm = %q, want the deployment under the cursor", m.confirm.resourceName)
+	}
+	_, cmd := pressKey(m, "y")
+	if msg, ok := cmd().(actionDoneMsg); !ok || !strings.Contains(msg.message, "api-deploy") {
+		t.Errorf("msg = %v, want the restart done", msg)
+	}
+}
+
+func TestRunBulk_BoundedConcurrency(t *testing.T) {
+	targets := make([]bulkTarget, 12)
+	for i := range targets {
+		targets[i].name = string(rune('a' + i))
+	}
+	var running, peak atomic.Int32
+	out := make(chan bulkResult, len(targets))
+	runBulk(targets, 3, func(bulkTarget) error {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	}, out)
+
+	if len(out) != 12 {
+		t.Errorf("%d results, want 12", len(out))
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("%d actions ran at once, want at most 3", p)
+	}
+}
+
+// markAllRows marks every row of the current view.
+func markAllRows(m Model) Model {
+	updated, _ := m.markAll()
+	return updated.(Model)
+}
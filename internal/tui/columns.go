@@ -0,0 +1,164 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+)
+
+// Terminal widths of the responsive layout (spec 4.3): NAME and STATUS
+// only below widthMain, the main columns up to widthStandard, all the
+// standard ones up to widthExtended, and NODE, IMAGE and NAMESPACE beyond.
+const (
+	widthMain     = 60
+	widthStandard = 100
+	widthExtended = 151
+)
+
+// compactHeight is the terminal height under which the tab bar is hidden.
+const compactHeight = 15
+
+// column is a column of a list view. It shows from the terminal width from
+// on; when the visible columns do not fit, the highest priority number
+// drops first. Flexible columns share the remaining width, up to maxWidth
+// when set.
+type column struct {
+	title    string
+	width    int // minimum width
+	maxWidth int
+	from     int
+	priority int
+	flex     bool
+	render   func(string) string // colors the cell after truncation
+}
+
+// layoutColumns returns the width of each column for a terminal of the
+// given width, 0 for the hidden ones. indent is the row prefix.
+func layoutColumns(cols []column, width, indent int) []int {
+	widths := make([]int, len(cols))
+	for i, c := range cols {
+		if width >= c.from {
+			widths[i] = c.width
+		}
+	}
+
+	used := func() int {
+		total, n := indent, 0
+		for _, w := range widths {
+			if w > 0 {
+				total += w
+				n++
+			}
+		}
+		return total + max(n-1, 0) // one space between columns
+	}
+
+	for used() > width {
+		drop := -1
+		for i, c := range cols {
+			if widths[i] > 0 && (drop < 0 || c.priority >= cols[drop].priority) {
+				drop = i
+			}
+		}
+		if drop < 0 || cols[drop].priority == 0 {
+			break // the first columns stay, truncated
+		}
+		widths[drop] = 0
+	}
+
+	for extra := width - used(); extra > 0; extra = width - used() {
+		var flex []int
+		for i, c := range cols {
+			if widths[i] > 0 && c.flex && (c.maxWidth == 0 || widths[i] < c.maxWidth) {
+				flex = append(flex, i)
+			}
+		}
+		if len(flex) == 0 {
+			break
+		}
+		for n, i := range flex {
+			share := extra / len(flex)
+			if n < extra%len(flex) {
+				share++
+			}
+			if cols[i].maxWidth > 0 {
+				share = min(share, cols[i].maxWidth-widths[i])
+			}
+			widths[i] += share
+		}
+	}
+	return widths
+}
+
+// renderColumnHeader renders the titles of the visible columns.
+func renderColumnHeader(cols []column, widths []int, indent string) string {
+	titles := make([]string, len(cols))
+	for i, c := range cols {
+		titles[i] = c.title
+	}
+	return headerStyle.Render(indent + joinCells(cols, widths, titles, false))
+}
+
+// renderColumnRow renders the cells of a row, truncated to their column.
+func renderColumnRow(cols []column, widths []int, prefix string, cells []string) string {
+	return prefix + joinCells(cols, widths, cells, true)
+}
+
+func joinCells(cols []column, widths []int, cells []string, styled bool) string {
+	var parts []string
+	last := -1
+	for i, w := range widths {
+		if w > 0 {
+			last = i
+		}
+	}
+	for i, w := range widths {
+		if w == 0 {
+			continue
+		}
+		cell := truncate(cells[i], w)
+		pad := ""
+		if i != last {
+			pad = strings.Repeat(" ", max(w-lipgloss.Width(cell), 0))
+		}
+		if styled && cols[i].render != nil {
+			cell = cols[i].render(cell)
+		}
+		parts = append(parts, cell+pad)
+	}
+	return strings.Join(parts, " ")
+}
+
+// extraColumns are the columns declared in config.yaml, after the standard
+// ones. They drop first when the width runs short.
+func extraColumns(specs []config.ColumnConfig) []column {
+	cols := make([]column, len(specs))
+	for i, s := range specs {
+		cols[i] = column{title: strings.ToUpper(s.Name), width: max(len(s.Name), 12), from: widthMain, priority: 20 + i}
+	}
+	return cols
+}
+
+// extraCells reads the values of the extra columns of a row.
+func extraCells(specs []config.ColumnConfig, extra map[string]string) []string {
+	cells := make([]string, len(specs))
+	for i, s := range specs {
+		cells[i] = extra[s.Name]
+	}
+	return cells
+}
+
+// columnsConfig is the columns section of config.yaml.
+func (m Model) columnsConfig() config.ColumnsConfig {
+	if m.cfg == nil {
+		return config.ColumnsConfig{}
+	}
+	return m.cfg.Columns
+}
+
+// compact reports whether the terminal is too short for the tab bar.
+func (m Model) compact() bool {
+	return m.height > 0 && m.height < compactHeight
+}
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// visibleTitles lists the columns shown at this width.
+func visibleTitles(cols []column, width int) string {
+	var titles []string
+	for i, w := range layoutColumns(cols, width, 2) {
+		if w > 0 {
+			titles = append(titles, cols[i].title)
+		}
+	}
+	return strings.Join(titles, " ")
+}
+
+func TestLayoutColumns_PodBreakpoints(t *testing.T) {
+	tests := []struct {
+		width int
+		allNS bool
+		want  string
+	}{
+		{50, false, "NAME STATUS"},
+		{80, false, "NAME STATUS READY AGE"},
+		{120, false, "NAME STATUS READY RESTARTS AGE"},
+		{160, false, "NAMESPACE NAME STATUS READY RESTARTS AGE NODE IMAGE"},
+		{90, true, "NAMESPACE NAME STATUS READY AGE"},
+		{66, true, "NAMESPACE NAME STATUS"}, // READY and AGE drop first
+	}
+	for _, tt := range tests {
+		if got := visibleTitles(podColumns(tt.allNS, nil), tt.width); got != tt.want {
+			t.Errorf("width %d, allNS %v: columns = %q, want %q", tt.width, tt.allNS, got, tt.want)
+		}
+	}
+}
+
+func TestLayoutColumns_FlexExpands(t *testing.T) {
+	cols := eventColumns(false, nil)
+	narrow := layoutColumns(cols, 110, 2)
+	wide := layoutColumns(cols, 150, 2)
+	if wide[4] != narrow[4]+40 {
+		t.Errorf("MESSAGE width = %d at 150, want %d", wide[4], narrow[4]+40)
+	}
+	if wide[2] != cols[2].width {
+		t.Error("fixed columns should keep their width")
+	}
+
+	total := 2
+	for _, w := range wide {
+		if w > 0 {
+			total += w + 1
+		}
+	}
+	if total-1 != 150 {
+		t.Errorf("columns use %d cells, want the full 150", total-1)
+	}
+
+	// NAME stops growing at its maximum width
+	if w := layoutColumns(podColumns(false, nil), 140, 2)[1]; w != 56 {
+		t.Errorf("NAME width = %d at 140, want its maximum 56", w)
+	}
+}
+
+func TestRenderPodList_TruncatesToColumn(t *testing.T) {
+	pods := []domain.PodInfo{{
+		Name: strings.Repeat("x", 80), Status: "Running", Ready: "1/1", Node: "worker-1",
+		Containers: []domain.ContainerInfo{{Name: "app", Image: "nginx:1.27"}},
+	}}
+	out := renderPodList(pods, 1, 70, 10, false, nil, nil)
+	for _, line := range strings.Split(strings.TrimRight(out, "\n"), "\n") {
+		if n := len([]rune(line)); n > 70 {
+			t.Errorf("line is %d wide, want at most 70: %q", n, line)
+		}
+	}
+	if !strings.Contains(out, "…") {
+		t.Error("a long name should be truncated")
+	}
+
+	out = renderPodList(pods, 1, 180, 10, false, nil, nil)
+	if !strings.Contains(out, "worker-1") || !strings.Contains(out, "nginx:1.27") {
+		t.Errorf("extended columns should show the node and the image:\n%s", out)
+	}
+}
+
+func TestCompactMode_HidesTabs(t *testing.T) {
+	m := newTestModel()
+	m.height = 30
+	if !strings.Contains(m.View(), "[2] Pods") {
+		t.Fatal("tabs should show on a normal terminal")
+	}
+	m.height = 12
+	if strings.Contains(m.View(), "[2] Pods") {
+		t.Error("tabs should be hidden under 15 lines")
+	}
+}
+
+func TestRenderPodList_ExtraColumns(t *testing.T) {
+	extra := []config.ColumnConfig{{Name: "qos", JSONPath: ".status.qosClass"}, {Name: "team", Label: "team"}}
+	pods := []domain.PodInfo{{
+		Name: "web-1", Status: "Running", Ready: "1/1",
+		Extra: map[string]string{"qos": "Burstable", "team": "payments"},
+	}}
+
+	out := renderPodList(pods, 1, 120, 10, false, extra, nil)
+	for _, want := range []string{"QOS", "TEAM", "Burstable", "payments"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+
+	// Extra columns give way first, the last declared first
+	if got := visibleTitles(podColumns(false, extra), 80); got != "NAME STATUS READY AGE QOS" {
+		t.Errorf("columns at 80 = %q, want TEAM dropped", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 type confirmMode int
@@ -21,6 +22,7 @@ type confirmState struct {
 	action       string // "Supprimer pod", "Scale deployment"
 	resourceName string
 	namespace    string
+	targets      []string // bulk actions: every resource acted upon
 	isProd       bool
 	input        textinput.Model
 	callback     func() tea.Msg // action to execute on confirm
@@ -52,11 +54,20 @@ func (cs *confirmState) activate(action, resourceName, namespace string, isProd
 	}
 }
 
+// activateBulk asks once for an action on several resources. summary, such
+// as "12 pods", stands for the resource name: it is what the user types in
+// a production namespace.
+func (cs *confirmState) activateBulk(action, summary string, targets []string, namespace string, isProd bool, callback func() tea.Msg) {
+	cs.activate(action, summary, namespace, isProd, callback)
+	cs.targets = targets
+}
+
 func (cs *confirmState) reset() {
 	cs.mode = confirmNone
 	cs.action = ""
 	cs.resourceName = ""
 	cs.namespace = ""
+	cs.targets = nil
 	cs.isProd = false
 	cs.input.SetValue("")
 	cs.input.Blur()
@@ -111,17 +122,26 @@ func (cs *confirmState) view(width int) string {
 	switch cs.mode {
 	case confirmSimple:
 		prompt := fmt.Sprintf("  %s %s ? [y/N] ", cs.action, cs.resourceName)
+		if len(cs.targets) > 0 {
+			prompt += "\n\n" + lipgloss.NewStyle().Width(max(width-4, 20)).PaddingLeft(4).
+				Render(strings.Join(cs.targets, ", ")) + "\n"
+		}
 		return "\n" + prompt
 	case confirmProd:
+		targets := ""
+		if len(cs.targets) > 0 {
+			targets = "  Liste  : " + strings.Join(cs.targets, ", ") + "\n"
+		}
 		box := fmt.Sprintf(
 			"  NAMESPACE PRODUCTION\n\n"+
 				"  Action : %s\n"+
 				"  Cible  : %s\n"+
+				"%s"+
 				"  NS     : %s\n\n"+
 				"  Tapez \"%s\" pour confirmer :\n"+
 				"  > %s\n\n"+
 				"  [Esc] Annuler",
-			cs.action, cs.resourceName, cs.namespace,
+			cs.action, cs.resourceName, targets, cs.namespace,
 			cs.resourceName, cs.input.View(),
 		)
 		return "\n" + bannerProdStyle.Width(min(width-4, 60)).Render(box) + "\n"
@@ -3,18 +3,98 @@ package tui
 import (
 	"fmt"
 	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
-func renderContainerSelector(podName string, choices []string, cursor int) string {
+// renderContainerSelector lists the choices; details, when set, is shown
+// next to each name (kind and state of the container).
+func renderContainerSelector(podName string, choices, details []string, cursor int) string {
 	var b strings.Builder
 	b.WriteString(fmt.Sprintf("\n  Container pour %s :\n\n", podName))
 	for i, name := range choices {
+		if i < len(details) && details[i] != "" {
+			name += "  " + lipgloss.NewStyle().Foreground(colorMuted).Render(details[i])
+		}
 		if i == cursor {
 			b.WriteString(fmt.Sprintf("  > %s\n", selectedStyle.Render(name)))
 		} else {
 			b.WriteString(fmt.Sprintf("    %s\n", name))
 		}
 	}
-	b.WriteString("\n  j/k:nav  enter:sélectionner  esc:annuler\n")
+	b.WriteString("\n  " + hints(hint("nav", keys.Down, keys.Up), hint("sélectionner", keys.Enter), hint("annuler", keys.Escape)) + "\n")
 	return b.String()
 }
+
+// appContainers returns the regular containers of a pod. Init and ephemeral
+// containers are only offered for logs.
+func appContainers(pod domain.PodInfo) []domain.ContainerInfo {
+	var cs []domain.ContainerInfo
+	for _, c := range pod.Containers {
+		if c.Kind == "" || c.Kind == domain.ContainerKindApp {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+func containerNames(cs []domain.ContainerInfo) []string {
+	names := make([]string, len(cs))
+	for i, c := range cs {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// containerDetail describes a container in the log selector, e.g.
+// "init, waiting: CrashLoopBackOff".
+func containerDetail(c domain.ContainerInfo) string {
+	var parts []string
+	if c.Kind == domain.ContainerKindInit || c.Kind == domain.ContainerKindEphemeral {
+		parts = append(parts, c.Kind)
+	}
+	state := c.State
+	if c.Reason != "" {
+		state += ": " + c.Reason
+	}
+	if state != "" {
+		parts = append(parts, state)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// defaultLogContainer picks the container preselected for logs: the first
+// failing init container, the first app container otherwise.
+func defaultLogContainer(pod domain.PodInfo) int {
+	for i, c := range pod.Containers {
+		if failingInit(c) {
+			return i
+		}
+	}
+	for i, c := range pod.Containers {
+		if c.Kind == "" || c.Kind == domain.ContainerKindApp {
+			return i
+		}
+	}
+	return 0
+}
+
+// failingInit reports an init container that waits on an error or ended
+// with one. The init containers after it wait with PodInitializing.
+func failingInit(c domain.ContainerInfo) bool {
+	if c.Kind != domain.ContainerKindInit {
+		return false
+	}
+	switch c.State {
+	case "waiting":
+		return c.Reason != "" && c.Reason != "PodInitializing"
+	case "terminated":
+		return c.Reason != "Completed"
+	}
+	return false
+}
@@ -144,7 +144,7 @@ func TestContainerSelector_EscCancels(t *testing.T) {
 }
 
 func TestContainerSelector_Render(t *testing.T) {
-	output := renderContainerSelector("web-1", []string{"app", "sidecar"}, 0)
+	output := renderContainerSelector("web-1", []string{"app", "sidecar"}, nil, 0)
 	if output == "" {
 		t.Fatal("render should return non-empty string")
 	}
@@ -181,3 +181,65 @@ func TestLogHeader_NoContainerName(t *testing.T) {
 		t.Error("log header should NOT show slash when no container name")
 	}
 }
+
+func TestEnterOnPodInInit_PreselectsFailingInitContainer(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Containers: []domain.ContainerInfo{
+				{Name: "setup", Kind: domain.ContainerKindInit, State: "terminated", Reason: "Completed"},
+				{Name: "migrate", Kind: domain.ContainerKindInit, State: "waiting", Reason: "CrashLoopBackOff"},
+				{Name: "app", Kind: domain.ContainerKindApp, State: "waiting", Reason: "PodInitializing"},
+			}},
+		},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	um := updated.(Model)
+
+	if !um.containerSelector {
+		t.Fatal("pod with init containers should show the selector")
+	}
+	if got := um.containerChoices[um.containerCursor]; got != "migrate" {
+		t.Errorf("preselected = %q, want migrate", got)
+	}
+	if !strings.Contains(um.View(), "(init, waiting: CrashLoopBackOff)") {
+		t.Error("selector should show the kind and state of init containers")
+	}
+
+	updated, _ = um.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	um = updated.(Model)
+	if um.view != ViewLogs || um.logState.containerName != "migrate" {
+		t.Errorf("view = %v, container = %q, want logs of migrate", um.view, um.logState.containerName)
+	}
+}
+
+func TestDefaultLogContainer_RunningPodSkipsInit(t *testing.T) {
+	pod := domain.PodInfo{Status: "Running", Containers: []domain.ContainerInfo{
+		{Name: "setup", Kind: domain.ContainerKindInit, State: "terminated", Reason: "Completed"},
+		{Name: "app", Kind: domain.ContainerKindApp, State: "running"},
+		{Name: "debugger-x", Kind: domain.ContainerKindEphemeral, State: "running"},
+	}}
+	if got := defaultLogContainer(pod); got != 1 {
+		t.Errorf("defaultLogContainer = %d, want 1", got)
+	}
+}
+
+func TestExecSelector_OnlyListsAppContainers(t *testing.T) {
+	pod := domain.PodInfo{Name: "web-1", Status: "Running", Containers: []domain.ContainerInfo{
+		{Name: "setup", Kind: domain.ContainerKindInit, State: "terminated", Reason: "Completed"},
+		{Name: "app", Kind: domain.ContainerKindApp, State: "running"},
+		{Name: "debugger-x", Kind: domain.ContainerKindEphemeral, State: "running"},
+	}}
+	if got := containerNames(appContainers(pod)); len(got) != 1 || got[0] != "app" {
+		t.Errorf("appContainers = %v, want [app]", got)
+	}
+	if got := runningContainers(pod); len(got) != 1 || got[0] != "app" {
+		t.Errorf("runningContainers = %v, want [app]", got)
+	}
+}
@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newDebugTestModel(ns string, pods []domain.PodInfo, cfg *config.AppConfig) (Model, *domain.MockGateway) {
+	mock := &domain.MockGateway{
+		NamespaceVal:       ns,
+		Pods:               pods,
+		DebugContainerName: "debugger-abcde",
+	}
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+	return m, mock
+}
+
+func TestDebugKey_SingleContainer_CreatesEphemeralContainer(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Exec.DebugImage = "nicolaka/netshoot"
+	m, mock := newDebugTestModel("default", []domain.PodInfo{
+		{Name: "web-1", Status: "Running", Containers: []domain.ContainerInfo{{Name: "app"}}},
+	}, cfg)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	um := updated.(Model)
+
+	if !um.loading {
+		t.Error("loading should be true while the debug container starts")
+	}
+	if cmd == nil {
+		t.Fatal("expected a cmd creating the debug container")
+	}
+	msg := cmd()
+	ready, ok := msg.(debugReadyMsg)
+	if !ok {
+		t.Fatalf("msg = %T, want debugReadyMsg", msg)
+	}
+	if ready.podName != "web-1" || ready.containerName != "debugger-abcde" {
+		t.Errorf("debugReadyMsg = %+v", ready)
+	}
+	if mock.DebugPod != "web-1" || mock.DebugTarget != "app" || mock.DebugImage != "nicolaka/netshoot" {
+		t.Errorf("CreateDebugContainer(%q, %q, %q)", mock.DebugPod, mock.DebugTarget, mock.DebugImage)
+	}
+}
+
+func TestDebugKey_MultiContainer_ShowsSelector(t *testing.T) {
+	m, mock := newDebugTestModel("default", []domain.PodInfo{{
+		Name:       "web-1",
+		Containers: []domain.ContainerInfo{{Name: "app"}, {Name: "sidecar"}},
+	}}, nil)
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	um := updated.(Model)
+	if !um.containerSelector || um.containerSelectorAction != "debug" {
+		t.Fatalf("selector = %v action = %q, want debug selector", um.containerSelector, um.containerSelectorAction)
+	}
+
+	updated, _ = um.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	updated, cmd := updated.(Model).Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a cmd after selecting a container")
+	}
+	cmd()
+	if mock.DebugTarget != "sidecar" {
+		t.Errorf("DebugTarget = %q, want sidecar", mock.DebugTarget)
+	}
+	_ = updated
+}
+
+func TestDebugKey_ReadonlyNamespace_Blocked(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ReadonlyNamespaces = []string{"kube-*"}
+	m, mock := newDebugTestModel("kube-system", []domain.PodInfo{{Name: "coredns-1"}}, cfg)
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	um := updated.(Model)
+
+	if !strings.Contains(um.toast.message, "lecture seule") {
+		t.Errorf("toast = %q, want readonly message", um.toast.message)
+	}
+	if mock.DebugPod != "" {
+		t.Error("CreateDebugContainer should not be called in a readonly namespace")
+	}
+}
+
+func TestDebugKey_ProdNamespace_RequiresConfirmation(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ProdPatterns = []string{"prod"}
+	m, mock := newDebugTestModel("api-prod", []domain.PodInfo{{Name: "web-1"}}, cfg)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	um := updated.(Model)
+
+	if cmd != nil {
+		t.Error("no cmd should run before confirmation")
+	}
+	if !um.confirm.isActive() || um.confirm.mode != confirmProd {
+		t.Fatal("expected prod confirmation")
+	}
+	if mock.DebugPod != "" {
+		t.Error("CreateDebugContainer should wait for confirmation")
+	}
+}
+
+func TestDebugCreateError_ShowsError(t *testing.T) {
+	m, mock := newDebugTestModel("default", []domain.PodInfo{{Name: "web-1"}}, nil)
+	mock.CreateDebugErr = &domain.APIError{Type: domain.ErrForbidden, Message: "interdit"}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if cmd == nil {
+		t.Fatal("expected a cmd")
+	}
+	if _, ok := cmd().(apiErrMsg); !ok {
+		t.Error("expected apiErrMsg on create failure")
+	}
+}
+
+func TestDebugReadyMsg_StartsExec(t *testing.T) {
+	m, mock := newDebugTestModel("default", []domain.PodInfo{{Name: "web-1"}}, nil)
+	m.loading = true
+
+	updated, _ := m.Update(debugReadyMsg{podName: "web-1", containerName: "debugger-abcde"})
+	um := updated.(Model)
+
+	if um.loading {
+		t.Error("loading should be cleared")
+	}
+	if mock.ExecPod != "web-1" || mock.ExecContainer != "debugger-abcde" {
+		t.Errorf("exec on %q/%q, want web-1/debugger-abcde", mock.ExecPod, mock.ExecContainer)
+	}
+}
@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func TestObjectEvents_FromPodsView(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal:  "default",
+		Pods:          []domain.PodInfo{{Name: "web-1", UID: "uid-1", Status: "Running"}},
+		ObjectEvents:  []domain.EventInfo{{Type: "Warning", Reason: "BackOff", Object: "Pod/web-1"}},
+		WatchEventsCh: make(chan domain.WatchEvent),
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	m = updated.(Model)
+	if m.view != ViewEvents || m.eventScope == nil {
+		t.Fatalf("view = %v, scope = %v, want scoped ViewEvents", m.view, m.eventScope)
+	}
+	if cmd == nil {
+		t.Fatal("expected a cmd loading the object's events")
+	}
+	updated, watchCmd := m.Update(cmd())
+	m = updated.(Model)
+
+	want := domain.ObjectRef{Kind: "Pod", Name: "web-1", UID: "uid-1"}
+	if mock.EventsRef != want {
+		t.Errorf("ListObjectEvents ref = %+v, want %+v", mock.EventsRef, want)
+	}
+	if mock.ListEventsCalls != 0 {
+		t.Error("scoped view should not list the whole namespace")
+	}
+	if watchCmd != nil || m.watching {
+		t.Error("scoped view should not watch the namespace events")
+	}
+	view := m.View()
+	if !strings.Contains(view, "Events de Pod/web-1") || !strings.Contains(view, "BackOff") {
+		t.Error("scoped view should show its object and events")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	m = updated.(Model)
+	if m.view != ViewPods || m.eventScope != nil {
+		t.Errorf("esc: view = %v, scope = %v, want ViewPods without scope", m.view, m.eventScope)
+	}
+}
+
+func TestObjectEvents_FromDeploymentsView(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Deployments:  []domain.DeploymentInfo{{Name: "web", UID: "dep-uid"}},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewDeployments
+	m.deployments = mock.Deployments
+	m.width = 120
+	m.height = 30
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if mock.EventsRef.Kind != "Deployment" || mock.EventsRef.Name != "web" {
+		t.Errorf("ListObjectEvents ref = %+v, want Deployment/web", mock.EventsRef)
+	}
+	if !strings.Contains(m.View(), "Aucun event pour Deployment/web") {
+		t.Error("empty scoped view should name the object")
+	}
+}
+
+func TestObjectEvents_TabClearsScope(t *testing.T) {
+	mock := &domain.MockGateway{NamespaceVal: "default", WatchEventsCh: make(chan domain.WatchEvent)}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewEvents
+	m.eventScope = &domain.ObjectRef{Kind: "Pod", Name: "web-1"}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}})
+	m = updated.(Model)
+	if m.eventScope != nil {
+		t.Fatal("switching tabs should drop the scope")
+	}
+	cmd()
+	if mock.ListEventsCalls != 1 {
+		t.Errorf("ListEventsCalls = %d, want 1", mock.ListEventsCalls)
+	}
+}
+
+func TestObjectEvents_Error(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal:        "default",
+		Pods:                []domain.PodInfo{{Name: "web-1", Status: "Running"}},
+		ListObjectEventsErr: &domain.APIError{Type: domain.ErrForbidden, Message: "forbidden"},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	m = updated.(Model)
+	if msg, ok := cmd().(ctxMsg).msg.(apiErrMsg); !ok || msg.err != mock.ListObjectEventsErr {
+		t.Errorf("msg = %v, want the ListObjectEvents error", msg)
+	}
+}
@@ -268,7 +268,7 @@ func TestRenderEventList_Columns(t *testing.T) {
 		{Type: "Normal", Reason: "Scheduled", Object: "Pod/web-2", Message: "Successfully assigned", Age: "5m", Count: 1},
 	}
 
-	output := renderEventList(events, 0, 120, 20)
+	output := renderEventList(events, 0, 120, 20, false, nil)
 
 	// Header columns
 	if !strings.Contains(output, "TYPE") {
@@ -300,7 +300,7 @@ func TestRenderEventList_Columns(t *testing.T) {
 }
 
 func TestRenderEventList_Empty(t *testing.T) {
-	output := renderEventList(nil, 0, 120, 20)
+	output := renderEventList(nil, 0, 120, 20, false, nil)
 
 	if !strings.Contains(output, "Aucun") {
 		t.Error("empty event list should show 'Aucun' message")
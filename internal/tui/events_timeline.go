@@ -0,0 +1,284 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// maxTimelineTransitions bounds the pod transitions kept by the timeline.
+const maxTimelineTransitions = 1000
+
+// timelineTransition is the type shown for pod state changes observed
+// through WatchPods, next to the Normal/Warning event types.
+const timelineTransition = "Transition"
+
+// timelineEntry is one step of an object's story: an event or a pod
+// transition.
+type timelineEntry struct {
+	at      time.Time
+	object  string // "Pod/web-1", as in EventInfo.Object
+	kind    string // "Normal", "Warning" or timelineTransition
+	reason  string
+	message string
+}
+
+// timelineRow is a line of the timeline: a group header when header is
+// set, an entry otherwise.
+type timelineRow struct {
+	header string
+	entry  timelineEntry
+}
+
+type timelineSeedMsg struct{ pods []domain.PodInfo }
+
+// timelinePodMsg and timelineStoppedMsg carry the channel they come from so
+// that messages from a stopped watch are ignored.
+type timelinePodMsg struct {
+	ch    <-chan domain.WatchEvent
+	event domain.WatchEvent
+}
+type timelineStoppedMsg struct{ ch <-chan domain.WatchEvent }
+
+// timelineState is the timeline mode of the Events view. Events come from
+// the view's own watch; pod transitions are derived from a pod watch by
+// comparing each update with the last state seen.
+type timelineState struct {
+	active      bool
+	pods        map[string]domain.PodInfo
+	transitions []timelineEntry
+	ch          <-chan domain.WatchEvent
+	cancel      context.CancelFunc
+}
+
+// seed records the pods as they were when the timeline opened, so that only
+// later changes show up as transitions.
+func (ts *timelineState) seed(pods []domain.PodInfo) {
+	ts.pods = make(map[string]domain.PodInfo, len(pods))
+	for _, p := range pods {
+		ts.pods[p.Name] = p
+	}
+}
+
+// observe records the transitions of a pod watch event.
+func (ts *timelineState) observe(evt domain.WatchEvent, now time.Time) {
+	if evt.Pod == nil {
+		return
+	}
+	if ts.pods == nil {
+		ts.pods = make(map[string]domain.PodInfo)
+	}
+	pod := *evt.Pod
+	old, known := ts.pods[pod.Name]
+	if evt.Type == domain.EventDeleted {
+		delete(ts.pods, pod.Name)
+		ts.add(timelineEntry{at: now, object: "Pod/" + pod.Name, kind: timelineTransition,
+			reason: "Deleted", message: "pod supprimé"})
+		return
+	}
+	ts.pods[pod.Name] = pod
+	var entries []timelineEntry
+	if known {
+		entries = podTransitions(old, pod, now)
+	} else {
+		entries = []timelineEntry{{at: now, object: "Pod/" + pod.Name, kind: timelineTransition,
+			reason: "Created", message: fmt.Sprintf("pod créé (%s)", pod.Status)}}
+	}
+	for _, e := range entries {
+		ts.add(e)
+	}
+}
+
+func (ts *timelineState) add(e timelineEntry) {
+	ts.transitions = append(ts.transitions, e)
+	if drop := len(ts.transitions) - maxTimelineTransitions; drop > 0 {
+		ts.transitions = ts.transitions[drop:]
+	}
+}
+
+// podTransitions compares two states of a pod: status changes and restarts,
+// with the reason of the container that restarted when known.
+func podTransitions(old, cur domain.PodInfo, now time.Time) []timelineEntry {
+	object := "Pod/" + cur.Name
+	var entries []timelineEntry
+	if old.Status != cur.Status {
+		entries = append(entries, timelineEntry{at: now, object: object, kind: timelineTransition,
+			reason: "StatusChanged", message: fmt.Sprintf("%s → %s", old.Status, cur.Status)})
+	}
+	if cur.Restarts > old.Restarts {
+		msg := fmt.Sprintf("redémarrages %d → %d", old.Restarts, cur.Restarts)
+		for _, c := range cur.Containers {
+			if !c.Ready && c.Reason != "" {
+				msg += fmt.Sprintf(" (%s : %s)", c.Name, c.Reason)
+				break
+			}
+		}
+		entries = append(entries, timelineEntry{at: now, object: object, kind: timelineTransition,
+			reason: "Restarted", message: msg})
+	}
+	return entries
+}
+
+// buildTimeline merges events and transitions, grouped by object. Entries
+// are chronological within a group; the group with the latest activity
+// comes first.
+func buildTimeline(events []domain.EventInfo, transitions []timelineEntry) []timelineRow {
+	groups := make(map[string][]timelineEntry)
+	for _, e := range events {
+		msg := e.Message
+		if e.Count > 1 {
+			msg += fmt.Sprintf(" (x%d)", e.Count)
+		}
+		groups[e.Object] = append(groups[e.Object], timelineEntry{at: e.CreatedAt, object: e.Object,
+			kind: e.Type, reason: e.Reason, message: msg})
+	}
+	for _, t := range transitions {
+		groups[t.object] = append(groups[t.object], t)
+	}
+
+	objects := make([]string, 0, len(groups))
+	last := make(map[string]time.Time, len(groups))
+	for obj, entries := range groups {
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].at.Before(entries[j].at) })
+		objects = append(objects, obj)
+		last[obj] = entries[len(entries)-1].at
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		if !last[objects[i]].Equal(last[objects[j]]) {
+			return last[objects[i]].After(last[objects[j]])
+		}
+		return objects[i] < objects[j]
+	})
+
+	var rows []timelineRow
+	for _, obj := range objects {
+		rows = append(rows, timelineRow{header: obj})
+		for _, e := range groups[obj] {
+			rows = append(rows, timelineRow{entry: e})
+		}
+	}
+	return rows
+}
+
+// timelineRows builds the timeline from the filtered events and the
+// transitions whose object or message matches the filter.
+func (m Model) timelineRows() []timelineRow {
+	f := m.filterText()
+	var transitions []timelineEntry
+	for _, t := range m.timeline.transitions {
+		if f == "" || strings.Contains(strings.ToLower(t.object), f) ||
+			strings.Contains(strings.ToLower(t.reason), f) ||
+			strings.Contains(strings.ToLower(t.message), f) {
+			transitions = append(transitions, t)
+		}
+	}
+	return buildTimeline(m.filteredEvents(), transitions)
+}
+
+func renderTimeline(rows []timelineRow, cursor, width, maxVisible int) string {
+	if len(rows) == 0 {
+		return "  Aucun event ni transition dans ce namespace\n"
+	}
+
+	var b strings.Builder
+	header := fmt.Sprintf("  %-10s %-12s %-22s %s", "HEURE", "TYPE", "REASON", "MESSAGE")
+	b.WriteString(headerStyle.Render(header))
+	b.WriteString("\n")
+
+	start := 0
+	if cursor >= maxVisible {
+		start = cursor - maxVisible + 1
+	}
+
+	for i := start; i < len(rows) && i < start+maxVisible; i++ {
+		r := rows[i]
+		var line string
+		if r.header != "" {
+			line = "  " + namespaceStyle.Render(r.header)
+		} else {
+			e := r.entry
+			at := "-"
+			if !e.at.IsZero() {
+				at = e.at.Local().Format("15:04:05")
+			}
+			kind := fmt.Sprintf("%-12s", e.kind)
+			switch e.kind {
+			case "Warning":
+				kind = eventWarningStyle.Render(kind)
+			case timelineTransition:
+				kind = timelineTransitionStyle.Render(kind)
+			default:
+				kind = eventNormalStyle.Render(kind)
+			}
+			// 49 columns before the message
+			line = fmt.Sprintf("  %-10s %s %-22s %s", at, kind, truncate(e.reason, 21), truncate(e.message, max(width-50, 20)))
+		}
+
+		if i == cursor {
+			b.WriteString(selectedStyle.Width(width).Render(line))
+		} else {
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// toggleTimeline switches the Events view between the table and the
+// timeline. The timeline lists the pods first to know their current state,
+// then watches them.
+func (m Model) toggleTimeline() (tea.Model, tea.Cmd) {
+	if m.timeline.active {
+		m.stopTimeline()
+		m.cursor = 0
+		return m, nil
+	}
+	m.timeline = timelineState{active: true}
+	m.cursor = 0
+	client := m.client
+	return m, func() tea.Msg {
+		pods, err := client.ListPods(context.Background())
+		if err != nil {
+			return apiErrMsg{err}
+		}
+		return timelineSeedMsg{pods}
+	}
+}
+
+func (m *Model) startTimelineWatch() tea.Cmd {
+	if m.timeline.cancel != nil {
+		m.timeline.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := m.client.WatchPods(ctx)
+	if err != nil || ch == nil {
+		cancel()
+		m.timeline.ch, m.timeline.cancel = nil, nil
+		return nil
+	}
+	m.timeline.ch, m.timeline.cancel = ch, cancel
+	return listenTimeline(ch)
+}
+
+func (m *Model) stopTimeline() {
+	if m.timeline.cancel != nil {
+		m.timeline.cancel()
+	}
+	m.timeline = timelineState{}
+}
+
+func listenTimeline(ch <-chan domain.WatchEvent) tea.Cmd {
+	return func() tea.Msg {
+		evt, ok := <-ch
+		if !ok {
+			return timelineStoppedMsg{ch}
+		}
+		return timelinePodMsg{ch: ch, event: evt}
+	}
+}
@@ -0,0 +1,153 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func TestPodTransitions_StatusAndRestarts(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	old := domain.PodInfo{Name: "web-1", Status: "Running", Restarts: 0}
+	cur := domain.PodInfo{Name: "web-1", Status: "CrashLoopBackOff", Restarts: 1, Containers: []domain.ContainerInfo{
+		{Name: "app", State: "waiting", Reason: "CrashLoopBackOff"},
+	}}
+
+	got := podTransitions(old, cur, now)
+	if len(got) != 2 {
+		t.Fatalf("transitions = %d, want 2", len(got))
+	}
+	if got[0].reason != "StatusChanged" || got[0].message != "Running → CrashLoopBackOff" {
+		t.Errorf("status transition = %+v", got[0])
+	}
+	if got[1].reason != "Restarted" || !strings.Contains(got[1].message, "0 → 1") || !strings.Contains(got[1].message, "app : CrashLoopBackOff") {
+		t.Errorf("restart transition = %+v", got[1])
+	}
+	if got[0].object != "Pod/web-1" {
+		t.Errorf("object = %q, want Pod/web-1", got[0].object)
+	}
+}
+
+func TestPodTransitions_Unchanged(t *testing.T) {
+	p := domain.PodInfo{Name: "web-1", Status: "Running", Restarts: 2}
+	if got := podTransitions(p, p, time.Now()); len(got) != 0 {
+		t.Errorf("transitions = %v, want none", got)
+	}
+}
+
+func TestTimelineObserve_SeededPodsOnlyReportChanges(t *testing.T) {
+	var ts timelineState
+	ts.seed([]domain.PodInfo{{Name: "web-1", Status: "Running"}})
+	now := time.Now()
+
+	// Initial ADDED of a known pod from the watch: nothing changed
+	ts.observe(domain.WatchEvent{Type: domain.EventAdded, Pod: &domain.PodInfo{Name: "web-1", Status: "Running"}}, now)
+	if len(ts.transitions) != 0 {
+		t.Fatalf("transitions = %v, want none", ts.transitions)
+	}
+
+	ts.observe(domain.WatchEvent{Type: domain.EventAdded, Pod: &domain.PodInfo{Name: "web-2", Status: "Pending"}}, now)
+	ts.observe(domain.WatchEvent{Type: domain.EventDeleted, Pod: &domain.PodInfo{Name: "web-1", Status: "Running"}}, now)
+	if len(ts.transitions) != 2 {
+		t.Fatalf("transitions = %d, want 2", len(ts.transitions))
+	}
+	if ts.transitions[0].reason != "Created" || ts.transitions[1].reason != "Deleted" {
+		t.Errorf("reasons = %q, %q", ts.transitions[0].reason, ts.transitions[1].reason)
+	}
+}
+
+func TestBuildTimeline_GroupsByObjectChronologically(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	events := []domain.EventInfo{
+		{Type: "Normal", Reason: "Started", Object: "Pod/web-1", CreatedAt: t0.Add(2 * time.Second)},
+		{Type: "Normal", Reason: "Pulled", Object: "Pod/web-1", CreatedAt: t0.Add(time.Second)},
+		{Type: "Normal", Reason: "ScalingReplicaSet", Object: "Deployment/web", CreatedAt: t0},
+		{Type: "Warning", Reason: "Unhealthy", Object: "Pod/web-1", CreatedAt: t0.Add(3 * time.Second), Count: 4},
+	}
+	transitions := []timelineEntry{
+		{at: t0.Add(4 * time.Second), object: "Pod/web-1", kind: timelineTransition, reason: "Restarted"},
+	}
+
+	rows := buildTimeline(events, transitions)
+
+	var got []string
+	for _, r := range rows {
+		if r.header != "" {
+			got = append(got, "# "+r.header)
+		} else {
+			got = append(got, r.entry.reason)
+		}
+	}
+	want := []string{"# Pod/web-1", "Pulled", "Started", "Unhealthy", "Restarted", "# Deployment/web", "ScalingReplicaSet"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("rows = %v, want %v", got, want)
+	}
+	if !strings.HasSuffix(rows[3].entry.message, "(x4)") {
+		t.Errorf("repeated event message = %q, want count suffix", rows[3].entry.message)
+	}
+}
+
+func TestToggleTimeline_SeedsThenWatchesPods(t *testing.T) {
+	podCh := make(chan domain.WatchEvent, 1)
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods:         []domain.PodInfo{{Name: "web-1", Status: "Running"}},
+		Events:       []domain.EventInfo{{Type: "Warning", Reason: "BackOff", Object: "Pod/web-1", CreatedAt: time.Now()}},
+		WatchPodsCh:  podCh,
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewEvents
+	m.events = mock.Events
+	m.width = 120
+	m.height = 30
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	m = updated.(Model)
+	if !m.timeline.active || cmd == nil {
+		t.Fatal("v should open the timeline and list the pods")
+	}
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	if m.timeline.ch == nil || cmd == nil {
+		t.Fatal("timeline should watch pods once seeded")
+	}
+
+	podCh <- domain.WatchEvent{Type: domain.EventModified, Pod: &domain.PodInfo{Name: "web-1", Status: "Error", Restarts: 1}}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if len(m.timeline.transitions) != 2 {
+		t.Fatalf("transitions = %d, want 2", len(m.timeline.transitions))
+	}
+
+	view := m.View()
+	for _, want := range []string{"Pod/web-1", "BackOff", "Running → Error", "Restarted"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("timeline view missing %q", want)
+		}
+	}
+	if m.listLen() != 4 {
+		t.Errorf("listLen = %d, want 4 (header + 3 entries)", m.listLen())
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	m = updated.(Model)
+	if m.timeline.active || m.timeline.ch != nil {
+		t.Error("v again should go back to the table and stop the pod watch")
+	}
+}
+
+func TestTimelinePodMsg_IgnoresStoppedWatch(t *testing.T) {
+	mock := &domain.MockGateway{NamespaceVal: "default"}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewEvents
+	stale := make(chan domain.WatchEvent)
+
+	updated, cmd := m.Update(timelinePodMsg{ch: stale, event: domain.WatchEvent{Pod: &domain.PodInfo{Name: "web-1"}}})
+	if cmd != nil || len(updated.(Model).timeline.transitions) != 0 {
+		t.Error("messages from a stopped watch should be ignored")
+	}
+}
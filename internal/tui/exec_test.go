@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"io"
 	"os/exec"
 	"strings"
 	"testing"
@@ -183,3 +184,119 @@ func TestExecDoneMsg_WithError_ShowsErrorToast(t *testing.T) {
 		t.Error("toast should be error level")
 	}
 }
+
+// fakeSession is a no-op domain.ExecSession.
+type fakeSession struct{}
+
+func (f *fakeSession) Run() error          { return nil }
+func (f *fakeSession) SetStdin(io.Reader)  {}
+func (f *fakeSession) SetStdout(io.Writer) {}
+func (f *fakeSession) SetStderr(io.Writer) {}
+
+func TestShellKey_NoExecTool_FallsBackToBuiltin(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Status: "Running"},
+		},
+		BuildExecErr: domain.ErrNoExecTool,
+		ExecSession:  &fakeSession{},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+
+	updated, resultCmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	um := updated.(Model)
+
+	if um.toast.isActive() {
+		t.Errorf("unexpected toast %q, should fall back to built-in exec", um.toast.message)
+	}
+	if resultCmd == nil {
+		t.Error("expected non-nil cmd for built-in exec")
+	}
+	if len(mock.ExecCommand) != 1 || mock.ExecCommand[0] != "/bin/sh" {
+		t.Errorf("ExecCommand = %v, want [/bin/sh]", mock.ExecCommand)
+	}
+}
+
+func TestShellKey_ExternalMode_NoFallback(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Status: "Running"},
+		},
+		BuildExecErr: domain.ErrNoExecTool,
+		ExecSession:  &fakeSession{},
+	}
+	cfg := config.DefaultConfig()
+	cfg.Exec.Mode = config.ExecModeExternal
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	um := updated.(Model)
+
+	if !um.toast.isActive() {
+		t.Error("expected error toast in external mode")
+	}
+	if mock.ExecCommand != nil {
+		t.Error("NewExecSession should not be called in external mode")
+	}
+}
+
+func TestShellKey_BuiltinMode_SkipsExternalTool(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Status: "Running", Containers: []domain.ContainerInfo{{Name: "main"}}},
+		},
+		ExecCmd:     exec.Command("echo", "test"),
+		ExecSession: &fakeSession{},
+	}
+	cfg := config.DefaultConfig()
+	cfg.Exec.Mode = config.ExecModeBuiltin
+	cfg.Exec.Shell = "/bin/bash"
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+
+	_, resultCmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	if resultCmd == nil {
+		t.Fatal("expected non-nil cmd for built-in exec")
+	}
+	if len(mock.ExecCommand) != 1 || mock.ExecCommand[0] != "/bin/bash" {
+		t.Errorf("ExecCommand = %v, want [/bin/bash]", mock.ExecCommand)
+	}
+}
+
+func TestShellKey_BuiltinSessionError_ShowsToast(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Status: "Running"},
+		},
+		BuildExecErr:      domain.ErrNoExecTool,
+		NewExecSessionErr: fmt.Errorf("exec intégré indisponible"),
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	um := updated.(Model)
+
+	if !um.toast.isActive() || !strings.Contains(um.toast.message, "intégré") {
+		t.Errorf("toast = %q, want built-in exec error", um.toast.message)
+	}
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type exportDoneMsg struct {
+	path     string
+	lines    int
+	filtered bool
+	err      error
+}
+
+var reUnsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// exportFileName builds names like namespace_pod_container_20240501-100000.log.
+// Empty parts are skipped and unsafe characters replaced.
+func exportFileName(parts []string, ext string, now time.Time) string {
+	var kept []string
+	for _, p := range parts {
+		if p = strings.Trim(reUnsafeFileChars.ReplaceAllString(p, "-"), "-"); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	kept = append(kept, now.Format("20060102-150405"))
+	return strings.Join(kept, "_") + ext
+}
+
+// expandHome resolves a leading ~ in the configured export directory.
+func expandHome(dir string) string {
+	if dir != "~" && !strings.HasPrefix(dir, "~/") {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return dir
+	}
+	return filepath.Join(home, dir[1:])
+}
+
+// writeExport writes content under dir, creating it if needed, and returns
+// the absolute path of the file.
+func writeExport(dir, name, content string) (string, error) {
+	dir = expandHome(dir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("création de %s : %w", dir, err)
+	}
+	path := filepath.Join(dir, name)
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("écriture de %s : %w", path, err)
+	}
+	return path, nil
+}
+
+// exportText renders the lines to save: the filtered view when a filter
+// is active, the whole buffer otherwise. Timestamps shown in the gutter and
+// aggregated source tags are kept; JSON lines are saved as received.
+func (ls *logState) exportText() (string, int) {
+	var b strings.Builder
+	n := ls.visibleCount()
+	for v := range n {
+		i := ls.lineAt(v)
+		if ls.showTimes && i < len(ls.times) && !ls.times[i].IsZero() {
+			b.WriteString(ls.times[i].Format(time.RFC3339Nano))
+			b.WriteString(" ")
+		}
+		if i < len(ls.sources) {
+			b.WriteString("[" + ls.sources[i] + "] ")
+		}
+		b.WriteString(ls.lines[i])
+		b.WriteString("\n")
+	}
+	return b.String(), n
+}
+
+// exportLogs saves the log view to a file named after the namespace, the
+// pod (or aggregated title) and the container.
+func (m Model) exportLogs() (tea.Model, tea.Cmd) {
+	if len(m.logState.lines) == 0 {
+		m.toast = newToast("Aucun log à exporter", toastError)
+		return m, scheduleToastClear()
+	}
+	parts := []string{m.targetNamespace(), m.logState.podName, m.logState.containerName}
+	if m.logState.aggregated() {
+		parts = []string{m.targetNamespace(), m.logState.title}
+	}
+	if m.logState.previous {
+		parts = append(parts, "previous")
+	}
+	name := exportFileName(parts, ".log", time.Now())
+	content, n := m.logState.exportText()
+	filtered := m.logState.filtered()
+	dir := m.cfg.Export.Dir
+	return m, func() tea.Msg {
+		path, err := writeExport(dir, name, content)
+		return exportDoneMsg{path: path, lines: n, filtered: filtered, err: err}
+	}
+}
+
+// exportYAML saves the YAML view to namespace_kind_name_timestamp.yaml.
+func (m Model) exportYAML() (tea.Model, tea.Cmd) {
+	if m.yamlState.content == "" {
+		m.toast = newToast("Aucun YAML à exporter", toastError)
+		return m, scheduleToastClear()
+	}
+	name := exportFileName([]string{m.targetNamespace(), m.yamlState.resourceType, m.yamlState.resourceName}, ".yaml", time.Now())
+	content := m.yamlState.content
+	n := len(m.yamlState.lines)
+	dir := m.cfg.Export.Dir
+	return m, func() tea.Msg {
+		path, err := writeExport(dir, name, content)
+		return exportDoneMsg{path: path, lines: n, err: err}
+	}
+}
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func TestExportFileName(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	if got := exportFileName([]string{"shop", "web-1", "app"}, ".log", now); got != "shop_web-1_app_20240501-103000.log" {
+		t.Errorf("name = %q", got)
+	}
+	if got := exportFileName([]string{"shop", "deploy/web", ""}, ".log", now); got != "shop_deploy-web_20240501-103000.log" {
+		t.Errorf("name = %q, want unsafe chars replaced and empty parts skipped", got)
+	}
+}
+
+func TestWriteExport_CreatesDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports", "nested")
+	path, err := writeExport(dir, "a.log", "hello\n")
+	if err != nil {
+		t.Fatalf("writeExport() error = %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "hello\n" || !filepath.IsAbs(path) {
+		t.Errorf("path = %q content = %q", path, data)
+	}
+}
+
+func TestExpandHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+	if got := expandHome("~/exports"); got != filepath.Join(home, "exports") {
+		t.Errorf("expandHome = %q", got)
+	}
+	if got := expandHome("/tmp/x"); got != "/tmp/x" {
+		t.Errorf("absolute dir changed: %q", got)
+	}
+}
+
+func TestLogState_ExportText(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	ls := logState{selector: "app=web", following: true}
+	ls.appendLogLines([]domain.LogLine{
+		{PodName: "web-1", Timestamp: ts, Text: "INFO up"},
+		{PodName: "web-2", Timestamp: ts.Add(time.Second), Text: "ERROR down"},
+	}, 10)
+
+	text, n := ls.exportText()
+	if n != 2 || text != "[web-1] INFO up\n[web-2] ERROR down\n" {
+		t.Errorf("raw export = %q (%d)", text, n)
+	}
+
+	ls.showTimes = true
+	ls.cycleLevel(10)
+	text, n = ls.exportText()
+	if n != 1 || text != "2024-05-01T10:00:01Z [web-2] ERROR down\n" {
+		t.Errorf("filtered export = %q (%d)", text, n)
+	}
+}
+
+func newExportTestModel(t *testing.T) (Model, string) {
+	t.Helper()
+	m, _ := newFollowTestModel()
+	dir := t.TempDir()
+	m.cfg.Export.Dir = dir
+	return m, dir
+}
+
+func TestExportKey_Logs(t *testing.T) {
+	m, dir := newExportTestModel(t)
+	m.logState.setContent("INFO a\nERROR b")
+	m.logState.cycleLevel(10)
+
+	m, cmd := pressKey(m, "S")
+	if cmd == nil {
+		t.Fatal("S should export the logs")
+	}
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "default_web-1_app_*.log"))
+	if len(files) != 1 {
+		t.Fatalf("files = %v, want one namespace_pod_container_timestamp.log", files)
+	}
+	if data, _ := os.ReadFile(files[0]); string(data) != "ERROR b\n" {
+		t.Errorf("content = %q, want the filtered view", data)
+	}
+	if !strings.Contains(m.toast.message, files[0]) || !strings.Contains(m.toast.message, "filtré") {
+		t.Errorf("toast = %q, want path and filtered mention", m.toast.message)
+	}
+}
+
+func TestExportKey_YAML(t *testing.T) {
+	m, dir := newExportTestModel(t)
+	m.view = ViewYAML
+	m.yamlState = yamlViewState{resourceName: "web", resourceType: "deployment"}
+	m.yamlState.setContent("kind: Deployment\n")
+
+	_, cmd := pressKey(m, "S")
+	if cmd == nil {
+		t.Fatal("S should export the YAML")
+	}
+	msg := cmd().(exportDoneMsg)
+	if msg.err != nil || filepath.Dir(msg.path) != dir || !strings.HasPrefix(filepath.Base(msg.path), "default_deployment_web_") {
+		t.Errorf("export = %+v", msg)
+	}
+}
+
+func TestExport_EmptyLogs(t *testing.T) {
+	m, _ := newExportTestModel(t)
+	m.logState = logState{podName: "web-1", following: true}
+
+	m, _ = pressKey(m, "S")
+	if m.toast.level != toastError {
+		t.Errorf("toast = %q, want error", m.toast.message)
+	}
+}
+
+func TestExport_WriteError(t *testing.T) {
+	m, dir := newExportTestModel(t)
+	blocker := filepath.Join(dir, "file")
+	_ = os.WriteFile(blocker, nil, 0o644)
+	m.cfg.Export.Dir = filepath.Join(blocker, "sub")
+
+	_, cmd := pressKey(m, "S")
+	updated, _ := m.Update(cmd())
+	if um := updated.(Model); um.toast.level != toastError || !strings.Contains(um.toast.message, "Export") {
+		t.Errorf("toast = %q, want export error", um.toast.message)
+	}
+}
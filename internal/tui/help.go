@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+)
+
+// helpGroup is a section of the help overlay. Its bindings are available in
+// the listed views, all of them when views is empty.
+type helpGroup struct {
+	title    string
+	views    []View
+	bindings func(km *keyMap) []*key.Binding
+	readonly bool // refused in readonly namespaces
+}
+
+// helpGroups lists the bindings by scope. They are read from keys when the
+// overlay is drawn, so remapped keys show up as such, and the scopes are
+// used to detect conflicting remaps.
+var helpGroups = []helpGroup{
+	{title: "Global", bindings: func(km *keyMap) []*key.Binding {
+		return []*key.Binding{&km.Help, &km.Palette, &km.Tab0, &km.Tab1, &km.Tab2, &km.Tab3, &km.Tab4,
+			&km.Tab5, &km.TabNext, &km.Refresh, &km.Escape, &km.Quit}
+	}},
+	{title: "Listes", views: []View{ViewOverview, ViewProjects, ViewPods, ViewDeployments, ViewEvents, ViewContexts},
+		bindings: func(km *keyMap) []*key.Binding {
+			return []*key.Binding{&km.Up, &km.Down, &km.Top, &km.Bottom, &km.PageUp, &km.PageDown,
+				&km.Enter, &km.Filter}
+		}},
+	{title: "Pods, deployments et events", views: []View{ViewPods, ViewDeployments, ViewEvents},
+		bindings: func(km *keyMap) []*key.Binding { return []*key.Binding{&km.Sort, &km.AllNS} }},
+	{title: "Pods", views: []View{ViewPods},
+		bindings: func(km *keyMap) []*key.Binding {
+			return []*key.Binding{&km.LabelLog, &km.Delete, &km.YAML, &km.ObjEvts, &km.Copy}
+		}},
+	{title: "Pods — exec", views: []View{ViewPods}, readonly: true,
+		bindings: func(km *keyMap) []*key.Binding {
+			return []*key.Binding{&km.Shell, &km.RunCmd, &km.Debug, &km.Download, &km.Upload}
+		}},
+	{title: "Deployments", views: []View{ViewDeployments},
+		bindings: func(km *keyMap) []*key.Binding {
+			return []*key.Binding{&km.ScaleUp, &km.ScaleDn, &km.ScaleSet, &km.Restart, &km.YAML, &km.ObjEvts}
+		}},
+	{title: "Sélection multiple", views: []View{ViewPods, ViewDeployments},
+		bindings: func(km *keyMap) []*key.Binding { return []*key.Binding{&km.Mark, &km.MarkAll, &km.Invert} }},
+	{title: "Events", views: []View{ViewEvents},
+		bindings: func(km *keyMap) []*key.Binding { return []*key.Binding{&km.Timeline} }},
+	{title: "Logs", views: []View{ViewLogs},
+		bindings: func(km *keyMap) []*key.Binding {
+			return []*key.Binding{&km.Up, &km.Down, &km.Top, &km.Bottom, &km.PageUp, &km.PageDown, &km.Filter,
+				&km.NextHit, &km.PrevHit, &km.Level, &km.Grep, &km.JSON, &km.FieldFlt, &km.Range, &km.Times,
+				&km.Previous, &km.Wrap, &km.Follow, &km.Export}
+		}},
+	{title: "YAML", views: []View{ViewYAML},
+		bindings: func(km *keyMap) []*key.Binding {
+			return []*key.Binding{&km.Up, &km.Down, &km.Top, &km.Bottom, &km.PageUp, &km.PageDown, &km.Export}
+		}},
+}
+
+// helpState is the full-screen help overlay.
+type helpState struct {
+	active bool
+	offset int
+}
+
+// helpLine is a line of the overlay: a group title when binding is nil.
+type helpLine struct {
+	title     string
+	binding   *key.Binding
+	available bool
+}
+
+// helpLines builds the overlay for the view it was opened from. Groups
+// available there come first, the most specific ones on top; the others
+// follow, greyed out.
+func (m Model) helpLines() []helpLine {
+	view := m.view
+	readonly := false
+	if m.client != nil && m.cfg != nil {
+		readonly = config.IsReadonlyNamespace(m.targetNamespace(), m.cfg.ReadonlyNamespaces)
+	}
+
+	groups := slices.Clone(helpGroups)
+	slices.SortStableFunc(groups, func(a, b helpGroup) int {
+		return groupScope(a) - groupScope(b)
+	})
+
+	var lines, greyed []helpLine
+	for _, g := range groups {
+		available := len(g.views) == 0 || slices.Contains(g.views, view)
+		title := g.title
+		if available && g.readonly && readonly {
+			title += " (namespace en lecture seule)"
+			available = false
+		}
+		group := []helpLine{{title: title}}
+		for _, b := range g.bindings(&keys) {
+			if b.Enabled() {
+				group = append(group, helpLine{binding: b, available: available})
+			}
+		}
+		if available {
+			lines = append(lines, group...)
+		} else {
+			greyed = append(greyed, group...)
+		}
+	}
+	return append(lines, greyed...)
+}
+
+// groupScope is the number of views of a group, global groups last.
+func groupScope(g helpGroup) int {
+	if len(g.views) == 0 {
+		return math.MaxInt32
+	}
+	return len(g.views)
+}
+
+func renderHelp(lines []helpLine, view View, offset, maxVisible int) string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(fmt.Sprintf("  Aide — vue %s (grisé : indisponible ici)", view)))
+	b.WriteString("\n")
+
+	offset = min(offset, max(len(lines)-maxVisible, 0))
+	for i := offset; i < len(lines) && i < offset+maxVisible; i++ {
+		l := lines[i]
+		if l.binding == nil {
+			b.WriteString("  " + namespaceStyle.Render(l.title) + "\n")
+			continue
+		}
+		h := l.binding.Help()
+		keyCol := fmt.Sprintf("%-12s", h.Key)
+		if l.available {
+			b.WriteString("    " + helpKeyStyle.Render(keyCol) + " " + h.Desc + "\n")
+		} else {
+			b.WriteString("    " + helpUnavailableStyle.Render(keyCol+" "+h.Desc) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func helpHelpKeys() string {
+	return hints(hint("défiler", keys.Down, keys.Up), hint("fermer", keys.Help, keys.Escape))
+}
+
+// handleHelpKey scrolls the overlay; ?, esc and q close it.
+func (m Model) handleHelpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	maxOffset := max(len(m.helpLines())-m.contentHeight(), 0)
+	switch {
+	case key.Matches(msg, keys.Help), key.Matches(msg, keys.Escape), key.Matches(msg, keys.Quit):
+		m.help = helpState{}
+	case key.Matches(msg, keys.Down):
+		m.help.offset = min(m.help.offset+1, maxOffset)
+	case key.Matches(msg, keys.Up):
+		m.help.offset = max(m.help.offset-1, 0)
+	case key.Matches(msg, keys.PageDown):
+		m.help.offset = min(m.help.offset+20, maxOffset)
+	case key.Matches(msg, keys.PageUp):
+		m.help.offset = max(m.help.offset-20, 0)
+	case key.Matches(msg, keys.Top):
+		m.help.offset = 0
+	case key.Matches(msg, keys.Bottom):
+		m.help.offset = maxOffset
+	}
+	return m, nil
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// helpAvailability maps the descriptions of the overlay to their
+// availability.
+func helpAvailability(lines []helpLine) map[string]bool {
+	avail := make(map[string]bool)
+	for _, l := range lines {
+		if l.binding != nil {
+			avail[l.binding.Help().Desc] = avail[l.binding.Help().Desc] || l.available
+		}
+	}
+	return avail
+}
+
+func TestHelpOverlay_OpenAndClose(t *testing.T) {
+	m := newTestModel()
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	m = updated.(Model)
+	if !m.help.active {
+		t.Fatal("? should open the help overlay")
+	}
+	view := m.View()
+	if !strings.Contains(view, "Aide — vue PODS") || !strings.Contains(view, "supprimer") {
+		t.Error("overlay should list the bindings of the current view")
+	}
+
+	// Keys scroll the overlay instead of acting on the list
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m = updated.(Model)
+	if m.cursor != 0 {
+		t.Error("j should not move the list cursor behind the overlay")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	m = updated.(Model)
+	if m.help.active {
+		t.Error("? again should close the overlay")
+	}
+}
+
+func TestHelpOverlay_GreysOutUnavailable(t *testing.T) {
+	m := newTestModel()
+	avail := helpAvailability(m.helpLines())
+	if !avail["supprimer"] || !avail["shell"] {
+		t.Error("pod actions should be available in the Pods view")
+	}
+	if avail["timeline"] || avail["scale up"] || avail["follow"] {
+		t.Error("actions of other views should be greyed out")
+	}
+	if !avail["quitter"] {
+		t.Error("global bindings should always be available")
+	}
+
+	m.view = ViewLogs
+	avail = helpAvailability(m.helpLines())
+	if !avail["follow"] || avail["supprimer"] {
+		t.Error("the Logs view should only enable log bindings")
+	}
+}
+
+func TestHelpOverlay_ReadonlyNamespace(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ReadonlyNamespaces = []string{"openshift-*"}
+	m := NewModel(&domain.MockGateway{NamespaceVal: "openshift-monitoring"}, nil, cfg)
+	m.view = ViewPods
+
+	avail := helpAvailability(m.helpLines())
+	if avail["shell"] || avail["debug"] {
+		t.Error("exec actions should be greyed out in a readonly namespace")
+	}
+	if !avail["yaml"] {
+		t.Error("read actions should stay available in a readonly namespace")
+	}
+}
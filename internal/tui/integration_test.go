@@ -39,6 +39,7 @@ func newTestModel() Model {
 	}
 
 	m := NewModel(mock, factory, nil)
+	m.view = ViewPods // the tests start from the pod list, not the overview
 	m.width = 120
 	m.height = 30
 	return m
@@ -529,9 +530,9 @@ func TestLoadCurrentViewPods(t *testing.T) {
 	m := newTestModel()
 	m.view = ViewPods
 
-	cmd := m.loadCurrentView()
+	cmd := m.loadView()
 	if cmd == nil {
-		t.Fatal("loadCurrentView should return a command for ViewPods")
+		t.Fatal("loadView should return a command for ViewPods")
 	}
 
 	// Execute the command to verify it calls the mock
@@ -550,7 +551,7 @@ func TestLoadCurrentViewDeployments(t *testing.T) {
 	m := newTestModel()
 	m.view = ViewDeployments
 
-	cmd := m.loadCurrentView()
+	cmd := m.loadView()
 	msg := cmd()
 	loaded, ok := msg.(deploymentsLoadedMsg)
 	if !ok {
@@ -565,7 +566,7 @@ func TestLoadCurrentViewNamespaces(t *testing.T) {
 	m := newTestModel()
 	m.view = ViewProjects
 
-	cmd := m.loadCurrentView()
+	cmd := m.loadView()
 	msg := cmd()
 	loaded, ok := msg.(namespacesLoadedMsg)
 	if !ok {
@@ -581,7 +582,7 @@ func TestLoadCurrentViewReturnsErrorMsg(t *testing.T) {
 	m.view = ViewPods
 	mockOf(m).ListPodsErr = &domain.APIError{Type: domain.ErrForbidden, Message: "forbidden"}
 
-	cmd := m.loadCurrentView()
+	cmd := m.loadView()
 	msg := cmd()
 	errMsg, ok := msg.(apiErrMsg)
 	if !ok {
@@ -691,7 +692,7 @@ func TestRenderPodList(t *testing.T) {
 	}
 
 	// Wide terminal
-	output := renderPodList(pods, 0, 120, 10)
+	output := renderPodList(pods, 0, 120, 10, false, nil, nil)
 	if !containsStr(output, "pod-a") {
 		t.Error("should contain pod-a")
 	}
@@ -703,14 +704,14 @@ func TestRenderPodList(t *testing.T) {
 	}
 
 	// Narrow terminal
-	output = renderPodList(pods, 0, 60, 10)
+	output = renderPodList(pods, 0, 60, 10, false, nil, nil)
 	if !containsStr(output, "pod-a") {
 		t.Error("narrow: should contain pod-a")
 	}
 }
 
 func TestRenderPodListEmpty(t *testing.T) {
-	output := renderPodList(nil, 0, 80, 10)
+	output := renderPodList(nil, 0, 80, 10, false, nil, nil)
 	if !containsStr(output, "Aucun pod") {
 		t.Error("empty list should show 'Aucun pod'")
 	}
@@ -724,7 +725,7 @@ func TestRenderDeploymentList(t *testing.T) {
 	}
 
 	// Wide
-	output := renderDeploymentList(deps, 0, 130, 10)
+	output := renderDeploymentList(deps, 0, 130, 10, false, nil, nil)
 	if !containsStr(output, "api") {
 		t.Error("should contain deployment name")
 	}
@@ -733,20 +734,20 @@ func TestRenderDeploymentList(t *testing.T) {
 	}
 
 	// Medium
-	output = renderDeploymentList(deps, 0, 90, 10)
+	output = renderDeploymentList(deps, 0, 90, 10, false, nil, nil)
 	if !containsStr(output, "AVAIL") {
 		t.Error("medium width should show AVAIL column")
 	}
 
 	// Narrow
-	output = renderDeploymentList(deps, 0, 60, 10)
+	output = renderDeploymentList(deps, 0, 60, 10, false, nil, nil)
 	if !containsStr(output, "api") {
 		t.Error("narrow: should still show name")
 	}
 }
 
 func TestRenderDeploymentListEmpty(t *testing.T) {
-	output := renderDeploymentList(nil, 0, 80, 10)
+	output := renderDeploymentList(nil, 0, 80, 10, false, nil, nil)
 	if !containsStr(output, "Aucun deployment") {
 		t.Error("empty list should show 'Aucun deployment'")
 	}
@@ -852,12 +853,12 @@ func TestScheduleToastClear(t *testing.T) {
 // --- logHelpKeys ---
 
 func TestLogHelpKeys(t *testing.T) {
-	current := logHelpKeys(false, false)
+	current := logHelpKeys(false, false, false)
 	if !containsStr(current, "précédents") {
 		t.Error("current logs help should mention 'précédents'")
 	}
 
-	previous := logHelpKeys(true, false)
+	previous := logHelpKeys(true, false, false)
 	if !containsStr(previous, "courants") {
 		t.Error("previous logs help should mention 'courants'")
 	}
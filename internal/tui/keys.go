@@ -1,6 +1,13 @@
 package tui
 
-import "github.com/charmbracelet/bubbles/key"
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
 
 type keyMap struct {
 	Up       key.Binding
@@ -12,22 +19,47 @@ type keyMap struct {
 	Enter    key.Binding
 	Escape   key.Binding
 	Filter   key.Binding
+	Palette  key.Binding
 	Refresh  key.Binding
 	Delete   key.Binding
 	ScaleUp  key.Binding
 	ScaleDn  key.Binding
 	ScaleSet key.Binding
+	Restart  key.Binding
+	Mark     key.Binding
+	MarkAll  key.Binding
+	Invert   key.Binding
 	Previous key.Binding
 	Wrap     key.Binding
+	Follow   key.Binding
+	LabelLog key.Binding
+	NextHit  key.Binding
+	PrevHit  key.Binding
+	Level    key.Binding
+	Grep     key.Binding
+	JSON     key.Binding
+	FieldFlt key.Binding
+	Range    key.Binding
+	Times    key.Binding
+	Export   key.Binding
 	Copy     key.Binding
 	Sort     key.Binding
+	AllNS    key.Binding
+	Timeline key.Binding
+	ObjEvts  key.Binding
 	YAML     key.Binding
 	Shell    key.Binding
+	RunCmd   key.Binding
+	Debug    key.Binding
+	Download key.Binding
+	Upload   key.Binding
 	Help     key.Binding
+	Tab0     key.Binding
 	Tab1     key.Binding
 	Tab2     key.Binding
 	Tab3     key.Binding
 	Tab4     key.Binding
+	Tab5     key.Binding
 	TabNext  key.Binding
 	Quit     key.Binding
 }
@@ -37,27 +69,206 @@ var keys = keyMap{
 	Down:     key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j/↓", "descendre")),
 	Top:      key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "début")),
 	Bottom:   key.NewBinding(key.WithKeys("G"), key.WithHelp("G", "fin")),
-	PageUp:   key.NewBinding(key.WithKeys("ctrl+u", "pgup"), key.WithHelp("C-u", "page up")),
-	PageDown: key.NewBinding(key.WithKeys("ctrl+d", "pgdown"), key.WithHelp("C-d", "page dn")),
+	PageUp:   key.NewBinding(key.WithKeys("pgup", "ctrl+u"), key.WithHelp("C-u", "page up")),
+	PageDown: key.NewBinding(key.WithKeys("pgdown", "ctrl+d"), key.WithHelp("C-d", "page dn")),
 	Enter:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "sélectionner")),
 	Escape:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "retour")),
 	Filter:   key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filtre")),
+	Palette:  key.NewBinding(key.WithKeys(":"), key.WithHelp(":", "commande")),
 	Refresh:  key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
 	Delete:   key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "supprimer")),
 	ScaleUp:  key.NewBinding(key.WithKeys("+"), key.WithHelp("+", "scale up")),
 	ScaleDn:  key.NewBinding(key.WithKeys("-"), key.WithHelp("-", "scale down")),
 	ScaleSet: key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "scale")),
+	Restart:  key.NewBinding(key.WithKeys("R"), key.WithHelp("R", "restart")),
+	Mark:     key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "marquer")),
+	MarkAll:  key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("C-a", "tout marquer")),
+	Invert:   key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "inverser les marques")),
 	Previous: key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "logs précédents")),
 	Wrap:     key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "wrap")),
+	Follow:   key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "follow")),
+	LabelLog: key.NewBinding(key.WithKeys("l"), key.WithHelp("l", "logs par label")),
+	NextHit:  key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "occurrence suivante")),
+	PrevHit:  key.NewBinding(key.WithKeys("N"), key.WithHelp("N", "occurrence précédente")),
+	Level:    key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "filtre niveau")),
+	Grep:     key.NewBinding(key.WithKeys("&"), key.WithHelp("&", "grep")),
+	JSON:     key.NewBinding(key.WithKeys("J"), key.WithHelp("J", "rendu json")),
+	FieldFlt: key.NewBinding(key.WithKeys("F"), key.WithHelp("F", "filtre champ json")),
+	Range:    key.NewBinding(key.WithKeys("R"), key.WithHelp("R", "période des logs")),
+	Times:    key.NewBinding(key.WithKeys("T"), key.WithHelp("T", "horodatage")),
+	Export:   key.NewBinding(key.WithKeys("S"), key.WithHelp("S", "exporter")),
 	Copy:     key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "copier nom")),
 	Sort:     key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "tri")),
+	AllNS:    key.NewBinding(key.WithKeys("A"), key.WithHelp("A", "tous les namespaces")),
+	Timeline: key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "timeline")),
+	ObjEvts:  key.NewBinding(key.WithKeys("E"), key.WithHelp("E", "events de l'objet")),
 	YAML:     key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "yaml")),
 	Shell:    key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "shell")),
+	RunCmd:   key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "commande")),
+	Debug:    key.NewBinding(key.WithKeys("b"), key.WithHelp("b", "debug")),
+	Download: key.NewBinding(key.WithKeys("D"), key.WithHelp("D", "télécharger")),
+	Upload:   key.NewBinding(key.WithKeys("U"), key.WithHelp("U", "envoyer")),
 	Help:     key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "aide")),
+	Tab0:     key.NewBinding(key.WithKeys("0"), key.WithHelp("0", "overview")),
 	Tab1:     key.NewBinding(key.WithKeys("1"), key.WithHelp("1", "projects")),
 	Tab2:     key.NewBinding(key.WithKeys("2"), key.WithHelp("2", "pods")),
 	Tab3:     key.NewBinding(key.WithKeys("3"), key.WithHelp("3", "deploys")),
 	Tab4:     key.NewBinding(key.WithKeys("4"), key.WithHelp("4", "events")),
+	Tab5:     key.NewBinding(key.WithKeys("5"), key.WithHelp("5", "contexts")),
 	TabNext:  key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "vue suivante")),
 	Quit:     key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quitter")),
 }
+
+// named maps the names used under keys: in config.yaml to the bindings.
+func (km *keyMap) named() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"up":             &km.Up,
+		"down":           &km.Down,
+		"top":            &km.Top,
+		"bottom":         &km.Bottom,
+		"page_up":        &km.PageUp,
+		"page_down":      &km.PageDown,
+		"enter":          &km.Enter,
+		"escape":         &km.Escape,
+		"filter":         &km.Filter,
+		"palette":        &km.Palette,
+		"refresh":        &km.Refresh,
+		"delete":         &km.Delete,
+		"scale_up":       &km.ScaleUp,
+		"scale_down":     &km.ScaleDn,
+		"scale_set":      &km.ScaleSet,
+		"restart":        &km.Restart,
+		"mark":           &km.Mark,
+		"mark_all":       &km.MarkAll,
+		"invert":         &km.Invert,
+		"previous":       &km.Previous,
+		"wrap":           &km.Wrap,
+		"follow":         &km.Follow,
+		"label_logs":     &km.LabelLog,
+		"next_hit":       &km.NextHit,
+		"prev_hit":       &km.PrevHit,
+		"level":          &km.Level,
+		"grep":           &km.Grep,
+		"json":           &km.JSON,
+		"field_filter":   &km.FieldFlt,
+		"range":          &km.Range,
+		"times":          &km.Times,
+		"export":         &km.Export,
+		"copy":           &km.Copy,
+		"sort":           &km.Sort,
+		"all_namespaces": &km.AllNS,
+		"timeline":       &km.Timeline,
+		"object_events":  &km.ObjEvts,
+		"yaml":           &km.YAML,
+		"shell":          &km.Shell,
+		"run":            &km.RunCmd,
+		"debug":          &km.Debug,
+		"download":       &km.Download,
+		"upload":         &km.Upload,
+		"help":           &km.Help,
+		"tab0":           &km.Tab0,
+		"tab1":           &km.Tab1,
+		"tab2":           &km.Tab2,
+		"tab3":           &km.Tab3,
+		"tab4":           &km.Tab4,
+		"tab5":           &km.Tab5,
+		"tab_next":       &km.TabNext,
+		"quit":           &km.Quit,
+	}
+}
+
+// ApplyKeyBindings remaps the bindings from the keys: section of
+// config.yaml. The keys replace the defaults of each named binding. The
+// bindings are left untouched when a name is unknown or when two bindings
+// available in the same view share a key.
+func ApplyKeyBindings(overrides map[string][]string) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	km := keys
+	named := km.named()
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b, ok := named[name]
+		if !ok {
+			valid := make([]string, 0, len(named))
+			for n := range named {
+				valid = append(valid, n)
+			}
+			sort.Strings(valid)
+			return fmt.Errorf("keys: action inconnue %q (actions : %s)", name, strings.Join(valid, ", "))
+		}
+		ks := overrides[name]
+		if len(ks) == 0 || slices.Contains(ks, "") {
+			return fmt.Errorf("keys: aucune touche pour %q", name)
+		}
+		// Bubble Tea names the space bar " "
+		matched := make([]string, len(ks))
+		for i, k := range ks {
+			if k == "space" {
+				k = " "
+			}
+			matched[i] = k
+		}
+		*b = key.NewBinding(key.WithKeys(matched...), key.WithHelp(strings.Join(ks, "/"), b.Help().Desc))
+	}
+
+	if err := km.conflicts(); err != nil {
+		return err
+	}
+	keys = km
+	return nil
+}
+
+// conflicts reports the first key bound twice in a view. Where a binding is
+// available is read from helpGroups.
+func (km *keyMap) conflicts() error {
+	names := make(map[*key.Binding]string)
+	for name, b := range km.named() {
+		names[b] = name
+	}
+	for v := ViewProjects; v < ViewError; v++ {
+		owner := make(map[string]string)
+		for _, g := range helpGroups {
+			if len(g.views) > 0 && !slices.Contains(g.views, v) {
+				continue
+			}
+			for _, b := range g.bindings(km) {
+				for _, k := range b.Keys() {
+					name := names[b]
+					if other, ok := owner[k]; ok && other != name {
+						first, second := min(other, name), max(other, name)
+						return fmt.Errorf("keys: conflit dans la vue %s, %q est lié à %s et à %s", v, k, first, second)
+					}
+					owner[k] = name
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hint formats a status bar hint from the first key of each binding, as in
+// "j/k:nav", so that remapped keys show up as such.
+func hint(label string, bindings ...key.Binding) string {
+	ks := make([]string, len(bindings))
+	for i, b := range bindings {
+		if bk := b.Keys(); len(bk) > 0 {
+			ks[i] = bk[0]
+		}
+		if ks[i] == " " {
+			ks[i] = "space"
+		}
+	}
+	return strings.Join(ks, "/") + ":" + label
+}
+
+// hints joins status bar hints.
+func hints(parts ...string) string {
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// restoreKeys puts the default bindings back after a remapping test.
+func restoreKeys(t *testing.T) {
+	t.Helper()
+	saved := keys
+	t.Cleanup(func() { keys = saved })
+}
+
+func TestDefaultKeys_NoConflict(t *testing.T) {
+	if err := keys.conflicts(); err != nil {
+		t.Errorf("default bindings conflict: %v", err)
+	}
+}
+
+func TestApplyKeyBindings_Remap(t *testing.T) {
+	restoreKeys(t)
+	if err := ApplyKeyBindings(map[string][]string{"scale_set": {"="}, "shell": {"S"}}); err != nil {
+		t.Fatalf("ApplyKeyBindings: %v", err)
+	}
+
+	if !strings.Contains(deploymentHelpKeys(), "=:scale set") || !strings.Contains(podHelpKeys(), "S:shell") {
+		t.Errorf("hints should show the remapped keys:\n%s\n%s", deploymentHelpKeys(), podHelpKeys())
+	}
+	if keys.Shell.Help().Key != "S" || keys.Shell.Help().Desc != "shell" {
+		t.Errorf("help = %+v, want the new key and the default description", keys.Shell.Help())
+	}
+
+	m := newTestModel()
+	m.view = ViewDeployments
+	m.deployments = []domain.DeploymentInfo{{Name: "web", Replicas: 1}}
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if updated.(Model).scaleActive {
+		t.Error("s should no longer open the scale input")
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'='}})
+	if !updated.(Model).scaleActive {
+		t.Error("= should open the scale input")
+	}
+}
+
+func TestApplyKeyBindings_Errors(t *testing.T) {
+	restoreKeys(t)
+	tests := []struct {
+		overrides map[string][]string
+		want      string
+	}{
+		{map[string][]string{"shel": {"S"}}, `action inconnue "shel"`},
+		{map[string][]string{"shell": {}}, `aucune touche pour "shell"`},
+		{map[string][]string{"delete": {"y"}}, `vue PODS, "y" est lié à delete et à yaml`},
+		{map[string][]string{"timeline": {"j"}}, `"j" est lié à down et à timeline`},
+	}
+	for _, tt := range tests {
+		err := ApplyKeyBindings(tt.overrides)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ApplyKeyBindings(%v) = %v, want %q", tt.overrides, err, tt.want)
+		}
+	}
+	if keys.Delete.Keys()[0] != "d" {
+		t.Error("a refused remap should leave the bindings untouched")
+	}
+}
+
+func TestApplyKeyBindings_SharedAcrossViews(t *testing.T) {
+	restoreKeys(t)
+	// Logs and pods never overlap
+	if err := ApplyKeyBindings(map[string][]string{"next_hit": {"d"}}); err != nil {
+		t.Errorf("a key reused in another view should be accepted: %v", err)
+	}
+}
+
+func TestApplyKeyBindings_Space(t *testing.T) {
+	restoreKeys(t)
+	if err := ApplyKeyBindings(map[string][]string{"mark": {"m"}, "invert": {"space"}}); err != nil {
+		t.Fatalf("ApplyKeyBindings: %v", err)
+	}
+	if !key.Matches(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}, keys.Invert) {
+		t.Error("\"space\" should bind the space bar")
+	}
+	if !strings.Contains(podHelpKeys(), "m/ctrl+a:marquer") {
+		t.Errorf("hints = %s, want the remapped mark key", podHelpKeys())
+	}
+}
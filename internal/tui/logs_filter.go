@@ -0,0 +1,215 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// logLevelFilter keeps the lines whose level, as classified by logLevel
+// for the colours, is at least the given severity.
+type logLevelFilter int
+
+const (
+	levelAll   logLevelFilter = iota
+	levelWarn                 // WARN and above
+	levelError                // ERROR and above
+)
+
+func (f logLevelFilter) String() string {
+	switch f {
+	case levelWarn:
+		return "WARN+"
+	case levelError:
+		return "ERROR+"
+	}
+	return ""
+}
+
+func (f logLevelFilter) keeps(line string) bool {
+	if f == levelAll {
+		return true
+	}
+	switch logLevel(line) {
+	case "ERROR", "FATAL", "SEVERE":
+		return true
+	case "WARN", "WARNING":
+		return f == levelWarn
+	}
+	return false
+}
+
+// grepFilter keeps the lines matching pattern, with before/after lines of
+// context around each of them, like grep -B/-A.
+type grepFilter struct {
+	pattern string
+	re      *regexp.Regexp
+	before  int
+	after   int
+}
+
+// parseGrepFilter parses a grep prompt such as "timeout -B 2 -A 5" or
+// "-C3 conn refused". Flags may come anywhere; the remaining words form the
+// pattern, compiled like a log search.
+func parseGrepFilter(input string) (*grepFilter, error) {
+	g := &grepFilter{}
+	var words []string
+	fields := strings.Fields(input)
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if len(f) < 2 || f[0] != '-' || !strings.Contains("ABC", f[1:2]) {
+			words = append(words, f)
+			continue
+		}
+		value := f[2:]
+		if value == "" && i+1 < len(fields) {
+			i++
+			value = fields[i]
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("contexte invalide pour %s : %q", f[:2], value)
+		}
+		switch f[1] {
+		case 'A':
+			g.after = n
+		case 'B':
+			g.before = n
+		case 'C':
+			g.before, g.after = n, n
+		}
+	}
+	g.pattern = strings.Join(words, " ")
+	if g.pattern == "" {
+		return nil, fmt.Errorf("motif vide")
+	}
+	re, err := compileLogSearch(g.pattern)
+	if err != nil {
+		return nil, err
+	}
+	g.re = re
+	return g, nil
+}
+
+func (g *grepFilter) String() string {
+	s := "/" + g.pattern
+	if g.before > 0 {
+		s += fmt.Sprintf(" -B%d", g.before)
+	}
+	if g.after > 0 {
+		s += fmt.Sprintf(" -A%d", g.after)
+	}
+	return s
+}
+
+func (ls *logState) filtered() bool {
+	return ls.level != levelAll || ls.grep != nil || ls.field != nil
+}
+
+// visibleCount is the number of lines of the (possibly filtered) view.
+func (ls *logState) visibleCount() int {
+	if ls.shown != nil {
+		return len(ls.shown)
+	}
+	return len(ls.lines)
+}
+
+// lineAt maps a line of the view to its index in the buffer.
+func (ls *logState) lineAt(i int) int {
+	if ls.shown != nil {
+		return ls.shown[i]
+	}
+	return i
+}
+
+// refilter rebuilds the view after the buffer changed, drop being the
+// number of lines removed from the top of the buffer. It returns how many
+// lines of the view were removed along with them.
+func (ls *logState) refilter(drop int) int {
+	if !ls.filtered() {
+		ls.shown = nil
+		return drop
+	}
+	shift := sort.SearchInts(ls.shown, drop)
+
+	keep := make([]bool, len(ls.lines))
+	for i, line := range ls.lines {
+		if !ls.level.keeps(line) || (ls.grep != nil && !ls.grep.re.MatchString(line)) ||
+			(ls.field != nil && !ls.field.keeps(line)) {
+			continue
+		}
+		keep[i] = true
+		if ls.grep == nil {
+			continue
+		}
+		for j := max(i-ls.grep.before, 0); j <= min(i+ls.grep.after, len(ls.lines)-1); j++ {
+			keep[j] = true
+		}
+	}
+	ls.shown = make([]int, 0, len(ls.lines))
+	for i, k := range keep {
+		if k {
+			ls.shown = append(ls.shown, i)
+		}
+	}
+	return shift
+}
+
+// applyFilter switches filters without refetching, keeping the line at the
+// top of the screen in view when it is still shown.
+func (ls *logState) applyFilter(viewHeight int) {
+	top := 0
+	if ls.offset < ls.visibleCount() {
+		top = ls.lineAt(ls.offset)
+	}
+	ls.shown = nil
+	ls.refilter(0)
+	if ls.shown != nil {
+		ls.offset = sort.SearchInts(ls.shown, top)
+	} else {
+		ls.offset = top
+	}
+	ls.offset = min(ls.offset, max(ls.visibleCount()-viewHeight, 0))
+	ls.matchIdx = 0
+	ls.updateMatches(0)
+	if ls.following && !ls.paused {
+		ls.jumpToBottom(viewHeight)
+	}
+}
+
+func (ls *logState) cycleLevel(viewHeight int) {
+	ls.level = (ls.level + 1) % 3
+	ls.applyFilter(viewHeight)
+}
+
+func (ls *logState) setGrep(g *grepFilter, viewHeight int) {
+	ls.grep = g
+	ls.applyFilter(viewHeight)
+}
+
+func (ls *logState) clearFilters(viewHeight int) {
+	ls.level = levelAll
+	ls.grep = nil
+	ls.field = nil
+	ls.applyFilter(viewHeight)
+}
+
+// filterStatus describes the active filters in the log header.
+func (ls *logState) filterStatus() string {
+	if !ls.filtered() {
+		return ""
+	}
+	var parts []string
+	if ls.level != levelAll {
+		parts = append(parts, ls.level.String())
+	}
+	if ls.grep != nil {
+		parts = append(parts, "grep "+ls.grep.String())
+	}
+	if ls.field != nil {
+		parts = append(parts, ls.field.String())
+	}
+	return fmt.Sprintf(" [filtre %s : %d/%d]", strings.Join(parts, ", "), len(ls.shown), len(ls.lines))
+}
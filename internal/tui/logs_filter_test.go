@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLogLevelFilter_Keeps(t *testing.T) {
+	tests := []struct {
+		filter logLevelFilter
+		line   string
+		want   bool
+	}{
+		{levelAll, "no level", true},
+		{levelWarn, "2024-01-01 WARN slow query", true},
+		{levelWarn, "ERROR boom", true},
+		{levelWarn, "INFO started", false},
+		{levelWarn, "\tat com.example.Main", false},
+		{levelError, "WARNING disk", false},
+		{levelError, "FATAL out of memory", true},
+		{levelError, `{"level":"error","msg":"boom"}`, true},
+		{levelWarn, `{"level":"warn","msg":"slow"}`, true},
+		{levelWarn, `{"level":"info","msg":"no error"}`, false}, // the level comes first
+		{levelWarn, "time=12:00 level=warning msg=disk", true},
+		{levelWarn, "retrying after connection error", false}, // free text
+	}
+	for _, tt := range tests {
+		if got := tt.filter.keeps(tt.line); got != tt.want {
+			t.Errorf("%v.keeps(%q) = %v, want %v", tt.filter, tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseGrepFilter(t *testing.T) {
+	g, err := parseGrepFilter("conn refused -B 2 -A5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.pattern != "conn refused" || g.before != 2 || g.after != 5 {
+		t.Errorf("grep = %+v", g)
+	}
+	if g.String() != "/conn refused -B2 -A5" {
+		t.Errorf("String() = %q", g.String())
+	}
+
+	g, _ = parseGrepFilter("-C3 timeout")
+	if g.before != 3 || g.after != 3 {
+		t.Errorf("-C3: before = %d after = %d", g.before, g.after)
+	}
+
+	for _, input := range []string{"-A x timeout", "-B 2", "a("} {
+		if _, err := parseGrepFilter(input); err == nil {
+			t.Errorf("parseGrepFilter(%q) should fail", input)
+		}
+	}
+}
+
+func TestLogState_GrepWithContext(t *testing.T) {
+	ls := newSearchLogState("a", "b", "c", "timeout", "d", "e", "f", "g", "timeout", "h")
+	g, _ := parseGrepFilter("timeout -B 1 -A 2")
+	ls.setGrep(g, 20)
+
+	var got []string
+	for v := range ls.visibleCount() {
+		got = append(got, ls.lines[ls.lineAt(v)])
+	}
+	if strings.Join(got, ",") != "c,timeout,d,e,g,timeout,h" {
+		t.Errorf("view = %v", got)
+	}
+	if len(ls.lines) != 10 {
+		t.Error("filtering must not touch the buffer")
+	}
+
+	ls.setGrep(nil, 20)
+	if ls.visibleCount() != 10 || ls.shown != nil {
+		t.Errorf("visible = %d, want the whole buffer back", ls.visibleCount())
+	}
+}
+
+func TestLogState_CycleLevel(t *testing.T) {
+	ls := newSearchLogState("INFO a", "WARN b", "ERROR c", "DEBUG d")
+
+	ls.cycleLevel(20)
+	if ls.level != levelWarn || ls.visibleCount() != 2 {
+		t.Errorf("level = %v visible = %d, want WARN+ with 2 lines", ls.level, ls.visibleCount())
+	}
+	ls.cycleLevel(20)
+	if ls.level != levelError || ls.visibleCount() != 1 {
+		t.Errorf("level = %v visible = %d, want ERROR+ with 1 line", ls.level, ls.visibleCount())
+	}
+	ls.cycleLevel(20)
+	if ls.filtered() || ls.visibleCount() != 4 {
+		t.Error("third press should remove the level filter")
+	}
+}
+
+func TestLogState_Filter_KeepsTopLine(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = "INFO ok"
+	}
+	lines[10], lines[60], lines[80] = "ERROR a", "ERROR b", "ERROR c"
+	ls := newSearchLogState(lines...)
+	ls.offset = 50
+
+	ls.cycleLevel(1)
+	if ls.lines[ls.lineAt(ls.offset)] != "ERROR b" {
+		t.Errorf("top line = %q, want the first error below the previous top", ls.lines[ls.lineAt(ls.offset)])
+	}
+}
+
+func TestLogState_Filter_FollowAppends(t *testing.T) {
+	ls := logState{following: true}
+	ls.appendLines([]string{"INFO a", "ERROR b"}, 10)
+	ls.cycleLevel(10)
+	ls.appendLines([]string{"INFO c", "ERROR d"}, 10)
+
+	if ls.visibleCount() != 2 || ls.lines[ls.lineAt(1)] != "ERROR d" {
+		t.Errorf("visible = %d, want new errors to show up", ls.visibleCount())
+	}
+}
+
+func TestLogState_Filter_FollowDropsOldest(t *testing.T) {
+	ls := logState{following: true, paused: true}
+	lines := make([]string, maxFollowLines)
+	lines[0], lines[5] = "ERROR old", "ERROR kept"
+	ls.appendLines(lines, 10)
+	ls.cycleLevel(10)
+	ls.offset = 1
+
+	ls.appendLines([]string{"x", "ERROR new"}, 10)
+
+	if ls.visibleCount() != 2 || ls.lines[ls.lineAt(0)] != "ERROR kept" {
+		t.Errorf("visible = %d first = %q", ls.visibleCount(), ls.lines[ls.lineAt(0)])
+	}
+	if ls.offset != 0 {
+		t.Errorf("offset = %d, want 0 after the top line was dropped", ls.offset)
+	}
+}
+
+func TestLogState_SearchWithinFilter(t *testing.T) {
+	ls := newSearchLogState("INFO db ok", "ERROR db down", "ERROR cache down")
+	ls.cycleLevel(10)
+	re, _ := compileLogSearch("db")
+	ls.setSearch("db", re, 10)
+
+	if len(ls.matches) != 1 || ls.matches[0] != 0 {
+		t.Errorf("matches = %v, want only the visible error line", ls.matches)
+	}
+}
+
+func TestRenderLogs_FilterStatus(t *testing.T) {
+	ls := newSearchLogState("INFO a", "ERROR b")
+	ls.cycleLevel(10)
+	output := renderLogs(&ls, 80, 10)
+	if !strings.Contains(output, "[filtre WARN+ : 1/2]") {
+		t.Errorf("output = %q, want filter status", output)
+	}
+	if strings.Contains(output, "INFO a") {
+		t.Error("filtered line should not be rendered")
+	}
+
+	ls.setGrep(&grepFilter{pattern: "zzz", re: mustSearch(t, "zzz")}, 10)
+	if output := renderLogs(&ls, 80, 10); !strings.Contains(output, "Aucune ligne") {
+		t.Errorf("output = %q, want empty filter message", output)
+	}
+}
+
+func TestGrepKey_FiltersAndEscapeClears(t *testing.T) {
+	m, _ := newFollowTestModel()
+	m.logState.setContent("start\nERROR boom\nretry\nok")
+
+	m, _ = pressKey(m, "&")
+	if !m.grepActive {
+		t.Fatal("& should open the grep prompt")
+	}
+	m = typeText(m, "boom -A 1")
+	m, cmd := pressKey(m, "enter")
+	if m.logState.visibleCount() != 2 {
+		t.Errorf("visible = %d, want match and one line after", m.logState.visibleCount())
+	}
+	if cmd != nil {
+		t.Error("filtering must not refetch logs")
+	}
+
+	m, _ = pressKey(m, "esc")
+	if m.view != ViewLogs || m.logState.filtered() {
+		t.Error("esc should clear the filter and stay in the logs view")
+	}
+}
+
+func mustSearch(t *testing.T, pattern string) *regexp.Regexp {
+	t.Helper()
+	re, err := compileLogSearch(pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return re
+}
@@ -0,0 +1,215 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// jsonLogMode selects how structured JSON log lines are rendered.
+type jsonLogMode int
+
+const (
+	jsonRaw      jsonLogMode = iota // line as received
+	jsonCompact                     // time LEVEL message key=value...
+	jsonExpanded                    // header line, then one key: value per line
+)
+
+func (m jsonLogMode) String() string {
+	switch m {
+	case jsonCompact:
+		return "json compact"
+	case jsonExpanded:
+		return "json détaillé"
+	}
+	return ""
+}
+
+// Well-known keys of structured loggers (zap, logrus, slog, bunyan, ECS).
+var (
+	jsonTimeKeys    = []string{"time", "ts", "timestamp", "@timestamp"}
+	jsonLevelKeys   = []string{"level", "lvl", "severity", "log.level"}
+	jsonMessageKeys = []string{"msg", "message"}
+)
+
+// parseJSONLog decodes a log line holding a JSON object. Numbers are kept
+// as written so that ids and durations are not reformatted.
+func parseJSONLog(line string) (map[string]any, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, false
+	}
+	return obj, true
+}
+
+// jsonField looks a key up, then falls back to a dotted path into nested
+// objects ("http.status").
+func jsonField(obj map[string]any, key string) (any, bool) {
+	if v, ok := obj[key]; ok {
+		return v, true
+	}
+	var cur any = obj
+	for _, part := range strings.Split(key, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func formatJSONValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return "null"
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// jsonHeader extracts time, level and message. It returns the header text
+// and the keys it consumed.
+func jsonHeader(obj map[string]any) (string, []string) {
+	var parts, used []string
+	for i, keys := range [][]string{jsonTimeKeys, jsonLevelKeys, jsonMessageKeys} {
+		for _, k := range keys {
+			v, ok := obj[k]
+			if !ok {
+				continue
+			}
+			s := formatJSONValue(v)
+			if i == 1 {
+				s = strings.ToUpper(s) // let colorizeLine color the level
+			}
+			parts = append(parts, s)
+			used = append(used, k)
+			break
+		}
+	}
+	return strings.Join(parts, " "), used
+}
+
+// otherJSONKeys lists, in order, the keys not shown in the header.
+func otherJSONKeys(obj map[string]any, used []string) []string {
+	var keys []string
+	for k := range obj {
+		if !slices.Contains(used, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// compactJSONLog renders "time LEVEL message key=value...". When fields is
+// set only those keys are appended, otherwise all the remaining ones.
+func compactJSONLog(obj map[string]any, fields []string) string {
+	header, used := jsonHeader(obj)
+	parts := []string{header}
+	if len(fields) > 0 {
+		for _, f := range fields {
+			if v, ok := jsonField(obj, f); ok {
+				parts = append(parts, f+"="+formatJSONValue(v))
+			}
+		}
+	} else {
+		for _, k := range otherJSONKeys(obj, used) {
+			parts = append(parts, k+"="+formatJSONValue(obj[k]))
+		}
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+// expandedJSONLog renders the header, then one indented key: value line per
+// remaining field.
+func expandedJSONLog(obj map[string]any) []string {
+	header, used := jsonHeader(obj)
+	lines := []string{header}
+	for _, k := range otherJSONKeys(obj, used) {
+		lines = append(lines, "    "+k+": "+formatJSONValue(obj[k]))
+	}
+	return lines
+}
+
+// displayLines returns the visual lines of a buffer line in the current
+// JSON mode. Lines that are not JSON objects are shown as is.
+func (ls *logState) displayLines(line string) []string {
+	if ls.jsonMode == jsonRaw {
+		return []string{line}
+	}
+	obj, ok := parseJSONLog(line)
+	if !ok {
+		return []string{line}
+	}
+	if ls.jsonMode == jsonCompact {
+		return []string{compactJSONLog(obj, ls.jsonFields)}
+	}
+	return expandedJSONLog(obj)
+}
+
+// cycleJSONMode switches to the next JSON mode. Lines change height with
+// the mode, so a followed log sticks to the bottom again.
+func (ls *logState) cycleJSONMode(fields []string, viewHeight int) {
+	ls.jsonMode = (ls.jsonMode + 1) % 3
+	ls.jsonFields = fields
+	if ls.following && !ls.paused {
+		ls.jumpToBottom(viewHeight)
+	} else {
+		ls.offset = min(ls.offset, ls.maxOffset(viewHeight))
+	}
+}
+
+// fieldFilter keeps the JSON lines whose field has the given value, e.g.
+// trace_id=4bf92f3577b34da6.
+type fieldFilter struct {
+	key   string
+	value string
+}
+
+func parseFieldFilter(input string) (*fieldFilter, error) {
+	k, v, ok := strings.Cut(strings.TrimSpace(input), "=")
+	k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+	if !ok || k == "" || v == "" {
+		return nil, fmt.Errorf("format attendu : champ=valeur")
+	}
+	return &fieldFilter{key: k, value: v}, nil
+}
+
+func (f *fieldFilter) keeps(line string) bool {
+	obj, ok := parseJSONLog(line)
+	if !ok {
+		return false
+	}
+	v, ok := jsonField(obj, f.key)
+	return ok && formatJSONValue(v) == f.value
+}
+
+func (f *fieldFilter) String() string {
+	return f.key + "=" + f.value
+}
+
+func (ls *logState) setFieldFilter(f *fieldFilter, viewHeight int) {
+	ls.field = f
+	ls.applyFilter(viewHeight)
+}
@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+const zapLine = `{"level":"error","ts":"2024-05-02T10:00:00Z","msg":"payment failed","trace_id":"abc123","http":{"status":502},"attempt":3}`
+
+func TestParseJSONLog(t *testing.T) {
+	obj, ok := parseJSONLog(zapLine)
+	if !ok {
+		t.Fatal("zap line should parse")
+	}
+	if formatJSONValue(obj["attempt"]) != "3" {
+		t.Errorf("attempt = %v, want number kept as written", obj["attempt"])
+	}
+	for _, line := range []string{"plain text", `{"truncated":`, `["array"]`, ""} {
+		if _, ok := parseJSONLog(line); ok {
+			t.Errorf("parseJSONLog(%q) should fail", line)
+		}
+	}
+}
+
+func TestJSONField_DottedPath(t *testing.T) {
+	obj, _ := parseJSONLog(zapLine)
+	if v, ok := jsonField(obj, "http.status"); !ok || formatJSONValue(v) != "502" {
+		t.Errorf("http.status = %v, %v", v, ok)
+	}
+	if _, ok := jsonField(obj, "http.method"); ok {
+		t.Error("missing nested key should not be found")
+	}
+}
+
+func TestCompactJSONLog(t *testing.T) {
+	obj, _ := parseJSONLog(zapLine)
+
+	got := compactJSONLog(obj, nil)
+	want := `2024-05-02T10:00:00Z ERROR payment failed attempt=3 http={"status":502} trace_id=abc123`
+	if got != want {
+		t.Errorf("compact = %q, want %q", got, want)
+	}
+
+	got = compactJSONLog(obj, []string{"trace_id", "http.status", "missing"})
+	if got != "2024-05-02T10:00:00Z ERROR payment failed trace_id=abc123 http.status=502" {
+		t.Errorf("compact with fields = %q", got)
+	}
+}
+
+func TestExpandedJSONLog(t *testing.T) {
+	obj, _ := parseJSONLog(`{"message":"ok","severity":"info","user":"bob"}`)
+	got := expandedJSONLog(obj)
+	if len(got) != 2 || got[0] != "INFO ok" || got[1] != "    user: bob" {
+		t.Errorf("expanded = %q", got)
+	}
+}
+
+func TestRenderLogs_JSONModes(t *testing.T) {
+	ls := newSearchLogState(zapLine, "plain line")
+
+	ls.cycleJSONMode([]string{"trace_id"}, 10)
+	output := renderLogs(&ls, 200, 10)
+	if !strings.Contains(output, "payment failed trace_id=abc123") || !strings.Contains(output, "json compact") {
+		t.Errorf("compact output = %q", output)
+	}
+	if !strings.Contains(output, "plain line") {
+		t.Error("non-JSON lines should be shown as is")
+	}
+
+	ls.cycleJSONMode(nil, 10)
+	output = renderLogs(&ls, 200, 10)
+	if !strings.Contains(output, "    trace_id: abc123") || !strings.Contains(output, "json détaillé") {
+		t.Errorf("expanded output = %q", output)
+	}
+
+	ls.cycleJSONMode(nil, 10)
+	if ls.jsonMode != jsonRaw || !strings.Contains(renderLogs(&ls, 400, 10), `"trace_id":"abc123"`) {
+		t.Error("third press should go back to raw lines")
+	}
+}
+
+func TestRenderLogs_ExpandedRespectsViewHeight(t *testing.T) {
+	ls := newSearchLogState(zapLine, zapLine)
+	ls.jsonMode = jsonExpanded
+
+	output := renderLogs(&ls, 200, 3)
+	// header + 3 visual lines
+	if got := strings.Count(output, "\n"); got != 4 {
+		t.Errorf("lines = %d, want 4", got)
+	}
+}
+
+func TestRenderLogs_ExpandedFollowShowsNewest(t *testing.T) {
+	ls := logState{podName: "web-1", following: true}
+	ls.jsonMode = jsonExpanded
+	ls.appendLines([]string{zapLine, zapLine, zapLine}, 8)
+	ls.appendLines([]string{`{"level":"info","msg":"newest","trace_id":"zzz"}`}, 8)
+
+	// 4 screen lines per zap line, 2 for the newest: only the last zap
+	// line fits above it
+	if ls.offset != 2 {
+		t.Errorf("offset = %d, want 2", ls.offset)
+	}
+	output := renderLogs(&ls, 200, 8)
+	if !strings.Contains(output, "newest") || !strings.Contains(output, "zzz") {
+		t.Errorf("the newest line should be on screen:\n%s", output)
+	}
+	if !strings.Contains(output, "[3-4/4]") {
+		t.Errorf("the header should count the lines on screen:\n%s", output)
+	}
+
+	ls.scrollUp(1)
+	ls.scrollDown(5, 8)
+	if ls.offset != 2 || ls.paused {
+		t.Errorf("offset = %d paused = %v, scrolling down should stop at the newest line", ls.offset, ls.paused)
+	}
+}
+
+func TestFieldFilter(t *testing.T) {
+	if _, err := parseFieldFilter("trace_id"); err == nil {
+		t.Error("missing value should fail")
+	}
+	f, err := parseFieldFilter(" http.status = 502 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.keeps(zapLine) || f.keeps(`{"http":{"status":200}}`) || f.keeps("plain") {
+		t.Error("field filter should keep only JSON lines with the value")
+	}
+
+	ls := newSearchLogState(zapLine, `{"msg":"other","trace_id":"zzz"}`, "plain")
+	f, _ = parseFieldFilter("trace_id=abc123")
+	ls.setFieldFilter(f, 10)
+	if ls.visibleCount() != 1 || !strings.Contains(ls.filterStatus(), "trace_id=abc123") {
+		t.Errorf("visible = %d status = %q", ls.visibleCount(), ls.filterStatus())
+	}
+}
+
+func TestJSONKeys_ModeAndFieldPrompt(t *testing.T) {
+	m, _ := newFollowTestModel()
+	m.cfg.Logs.JSONFields = []string{"trace_id"}
+	m.logState.setContent(zapLine + "\n" + `{"msg":"x","trace_id":"other"}`)
+
+	m, _ = pressKey(m, "J")
+	if m.logState.jsonMode != jsonCompact || len(m.logState.jsonFields) != 1 {
+		t.Errorf("mode = %v fields = %v", m.logState.jsonMode, m.logState.jsonFields)
+	}
+
+	m, _ = pressKey(m, "F")
+	if !m.fieldActive {
+		t.Fatal("F should open the field prompt")
+	}
+	m = typeText(m, "trace_id=abc123")
+	m, _ = pressKey(m, "enter")
+	if m.logState.visibleCount() != 1 {
+		t.Errorf("visible = %d, want 1", m.logState.visibleCount())
+	}
+
+	m, _ = pressKey(m, "F")
+	if m.fieldInput.Value() != "trace_id=abc123" {
+		t.Errorf("prompt = %q, want the active filter prefilled", m.fieldInput.Value())
+	}
+	m.fieldInput.SetValue("")
+	m = typeText(m, "=")
+	m, _ = pressKey(m, "enter")
+	if m.toast.level != toastError || !m.fieldActive {
+		t.Errorf("invalid filter: toast = %q active = %v", m.toast.message, m.fieldActive)
+	}
+}
@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// multiLogTailLines is the backlog fetched for each container when an
+// aggregated log view starts on the "last N lines" range.
+const multiLogTailLines = 50
+
+// logAggregator follows the logs of every running container of the pods
+// matching a label selector, stern-style. Pods that appear or start later are
+// picked up from WatchPods. All streams are merged into a single channel,
+// closed once ctx is cancelled and every stream has stopped.
+type logAggregator struct {
+	client domain.KubeGateway
+	sel    labelSelector
+	opts   domain.LogOptions // range of the first stream of each container
+	out    chan domain.LogLine
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	active map[string]bool // pod/container currently streamed
+	seen   map[string]bool // pod/container streamed at least once
+}
+
+func startLogAggregator(ctx context.Context, client domain.KubeGateway, sel labelSelector, opts domain.LogOptions) <-chan domain.LogLine {
+	a := &logAggregator{
+		client: client,
+		sel:    sel,
+		opts:   opts,
+		out:    make(chan domain.LogLine, 256),
+		active: make(map[string]bool),
+		seen:   make(map[string]bool),
+	}
+	a.wg.Add(1)
+	go a.run(ctx)
+	go func() {
+		a.wg.Wait()
+		close(a.out)
+	}()
+	return a.out
+}
+
+func (a *logAggregator) run(ctx context.Context) {
+	defer a.wg.Done()
+
+	if pods, err := a.client.ListPods(ctx); err == nil {
+		for _, pod := range pods {
+			a.follow(ctx, pod)
+		}
+	}
+
+	events, err := a.client.WatchPods(ctx)
+	if err != nil || events == nil {
+		<-ctx.Done()
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case evt, ok := <-events:
+			if !ok {
+				<-ctx.Done()
+				return
+			}
+			if evt.Pod != nil && evt.Type != domain.EventDeleted {
+				a.follow(ctx, *evt.Pod)
+			}
+		}
+	}
+}
+
+// follow starts a stream for each running container of pod that is not
+// streamed yet. A container that restarts is resumed without its backlog.
+func (a *logAggregator) follow(ctx context.Context, pod domain.PodInfo) {
+	if !a.sel.matches(pod.Labels) {
+		return
+	}
+	// In all-namespaces mode each pod streams from its own namespace
+	client := a.client
+	if client.AllNamespaces() && pod.Namespace != "" {
+		client = client.InNamespace(pod.Namespace)
+	}
+	for _, container := range runningContainers(pod) {
+		key := pod.Namespace + "/" + pod.Name + "/" + container
+		a.mu.Lock()
+		if a.active[key] {
+			a.mu.Unlock()
+			continue
+		}
+		opts := a.opts
+		if a.seen[key] {
+			opts = domain.LogOptions{TailLines: 0}
+		}
+		a.active[key] = true
+		a.seen[key] = true
+		a.mu.Unlock()
+
+		lines, err := client.StreamPodLogs(ctx, pod.Name, container, opts)
+		if err != nil || lines == nil {
+			a.release(key)
+			continue
+		}
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			defer a.release(key)
+			for line := range lines {
+				select {
+				case a.out <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+}
+
+func (a *logAggregator) release(key string) {
+	a.mu.Lock()
+	delete(a.active, key)
+	a.mu.Unlock()
+}
+
+// runningContainers lists the containers worth streaming, running init
+// sidecars included but not debug containers. Pods reported
+// without container details are streamed through their default container.
+func runningContainers(pod domain.PodInfo) []string {
+	if len(pod.Containers) == 0 {
+		if pod.Status == "Running" {
+			return []string{""}
+		}
+		return nil
+	}
+	var names []string
+	for _, c := range pod.Containers {
+		if c.State == "running" && c.Kind != domain.ContainerKindEphemeral {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// logSource is the tag shown in front of an aggregated log line.
+func logSource(line domain.LogLine) string {
+	if line.ContainerName == "" {
+		return line.PodName
+	}
+	return line.PodName + "/" + line.ContainerName
+}
+
+// renderLogSource colors a tag by its pod so that each replica keeps the
+// same color for the whole session.
+func renderLogSource(source string) string {
+	pod, _, _ := strings.Cut(source, "/")
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(pod))
+	color := logSourceColors[h.Sum32()%uint32(len(logSourceColors))]
+	return lipgloss.NewStyle().Foreground(color).Render(source)
+}
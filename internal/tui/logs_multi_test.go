@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func runningPod(name string, labels map[string]string) domain.PodInfo {
+	return domain.PodInfo{
+		Name:       name,
+		Status:     "Running",
+		Labels:     labels,
+		Containers: []domain.ContainerInfo{{Name: "app", State: "running"}},
+	}
+}
+
+func recvLine(t *testing.T, ch <-chan domain.LogLine) domain.LogLine {
+	t.Helper()
+	select {
+	case line := <-ch:
+		return line
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a log line")
+		return domain.LogLine{}
+	}
+}
+
+func TestLogAggregator_FollowsMatchingPodsAndNewOnes(t *testing.T) {
+	web := map[string]string{"app": "web"}
+	mock := &domain.MockGateway{
+		Pods: []domain.PodInfo{
+			runningPod("web-1", web),
+			runningPod("db-1", map[string]string{"app": "db"}),
+		},
+		WatchPodsCh: make(chan domain.WatchEvent),
+		LogStreams: map[string]chan domain.LogLine{
+			"web-1/app": make(chan domain.LogLine, 1),
+			"web-2/app": make(chan domain.LogLine, 1),
+		},
+	}
+	sel, _ := parseLabelSelector("app=web")
+	ctx, cancel := context.WithCancel(context.Background())
+	out := startLogAggregator(ctx, mock, sel, domain.LogOptions{TailLines: multiLogTailLines})
+
+	mock.LogStreams["web-1/app"] <- domain.LogLine{PodName: "web-1", ContainerName: "app", Text: "hello"}
+	if line := recvLine(t, out); line.PodName != "web-1" || line.Text != "hello" {
+		t.Errorf("line = %+v, want web-1 hello", line)
+	}
+	if mock.LogOpts.TailLines != multiLogTailLines {
+		t.Errorf("opts = %+v, want the aggregator range", mock.LogOpts)
+	}
+
+	mock.WatchPodsCh <- domain.WatchEvent{Type: domain.EventAdded, Pod: &domain.PodInfo{
+		Name: "web-2", Labels: web, Containers: []domain.ContainerInfo{{Name: "app", State: "running"}},
+	}}
+	mock.LogStreams["web-2/app"] <- domain.LogLine{PodName: "web-2", ContainerName: "app", Text: "new replica"}
+	if line := recvLine(t, out); line.PodName != "web-2" {
+		t.Errorf("line = %+v, want a line from the new pod web-2", line)
+	}
+
+	cancel()
+	for _, ch := range mock.LogStreams {
+		close(ch)
+	}
+	for range out {
+	}
+}
+
+func TestRunningContainers(t *testing.T) {
+	pod := domain.PodInfo{Containers: []domain.ContainerInfo{
+		{Name: "app", State: "running"},
+		{Name: "sidecar", State: "waiting"},
+	}}
+	if got := runningContainers(pod); len(got) != 1 || got[0] != "app" {
+		t.Errorf("runningContainers() = %v, want [app]", got)
+	}
+	if got := runningContainers(domain.PodInfo{Status: "Running"}); len(got) != 1 || got[0] != "" {
+		t.Errorf("pod without container details = %v, want default container", got)
+	}
+	if got := runningContainers(domain.PodInfo{Status: "Pending"}); got != nil {
+		t.Errorf("pending pod = %v, want none", got)
+	}
+}
+
+func TestLogState_AppendLogLines_InterleavesByTimestamp(t *testing.T) {
+	base := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	ls := logState{selector: "app=web", following: true}
+
+	ls.appendLogLines([]domain.LogLine{
+		{PodName: "web-1", Timestamp: base, Text: "a"},
+		{PodName: "web-1", Timestamp: base.Add(2 * time.Second), Text: "c"},
+	}, 10)
+	ls.appendLogLines([]domain.LogLine{
+		{PodName: "web-2", ContainerName: "app", Timestamp: base.Add(time.Second), Text: "b"},
+	}, 10)
+
+	if got := strings.Join(ls.lines, ""); got != "abc" {
+		t.Errorf("lines = %q, want abc ordered by timestamp", got)
+	}
+	if ls.sources[1] != "web-2/app" || ls.sources[0] != "web-1" {
+		t.Errorf("sources = %v", ls.sources)
+	}
+}
+
+func TestRenderLogs_AggregatedShowsSourceTag(t *testing.T) {
+	ls := logState{selector: "app=web", title: "deploy/web", following: true}
+	ls.appendLogLines([]domain.LogLine{{PodName: "web-1", ContainerName: "app", Text: "ready"}}, 10)
+
+	output := renderLogs(&ls, 120, 10)
+	if !strings.Contains(output, "Logs agrégés: deploy/web") {
+		t.Errorf("header missing, got %q", output)
+	}
+	if !strings.Contains(output, "web-1/app") || !strings.Contains(output, "ready") {
+		t.Errorf("line should be tagged with pod/container, got %q", output)
+	}
+}
+
+func TestDeploymentEnter_OpensAggregatedLogs(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Deployments:  []domain.DeploymentInfo{{Name: "web", Selector: "app=web"}},
+		Pods:         []domain.PodInfo{runningPod("web-1", map[string]string{"app": "web"})},
+		LogStreams:   map[string]chan domain.LogLine{"web-1/app": make(chan domain.LogLine, 1)},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewDeployments
+	m.deployments = mock.Deployments
+	m.width = 120
+	m.height = 30
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.view != ViewLogs || !m.logState.aggregated() || !m.logState.following {
+		t.Fatalf("view = %v aggregated = %v, want aggregated follow", m.view, m.logState.aggregated())
+	}
+
+	mock.LogStreams["web-1/app"] <- domain.LogLine{PodName: "web-1", ContainerName: "app", Text: "up"}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if len(m.logState.lines) != 1 || m.logState.sources[0] != "web-1/app" {
+		t.Errorf("lines = %v sources = %v", m.logState.lines, m.logState.sources)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if updated.(Model).logCancel != nil {
+		t.Error("leaving the view should stop the aggregation")
+	}
+}
+
+func TestDeploymentEnter_NoSelector_ShowsToast(t *testing.T) {
+	mock := &domain.MockGateway{Deployments: []domain.DeploymentInfo{{Name: "web"}}}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewDeployments
+	m.deployments = mock.Deployments
+	m.width = 120
+	m.height = 30
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if um := updated.(Model); um.view != ViewDeployments || !strings.Contains(um.toast.message, "sélecteur") {
+		t.Errorf("view = %v toast = %q", um.view, um.toast.message)
+	}
+}
+
+func TestLabelKey_PromptOpensAggregatedLogs(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		LogStreams:   map[string]chan domain.LogLine{},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.width = 120
+	m.height = 30
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	m = updated.(Model)
+	if !m.labelActive {
+		t.Fatal("l should open the label selector prompt")
+	}
+	m = typeText(m, "tier=front")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if m.view != ViewLogs || m.logState.selector != "tier=front" {
+		t.Errorf("view = %v selector = %q", m.view, m.logState.selector)
+	}
+	m.stopLogFollow()
+}
+
+func TestLabelKey_InvalidSelector_ShowsToast(t *testing.T) {
+	m := NewModel(&domain.MockGateway{}, nil, nil)
+	m.view = ViewPods
+	m.width = 120
+	m.height = 30
+	m.labelActive = true
+	m.labelInput.Focus()
+	m = typeText(m, "a b")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if um := updated.(Model); um.view != ViewPods || !strings.Contains(um.toast.message, "invalide") {
+		t.Errorf("view = %v toast = %q", um.view, um.toast.message)
+	}
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// logRange is the part of the log fetched from the API.
+type logRange int
+
+const (
+	rangeTail       logRange = iota // last N lines
+	range15m                        // last 15 minutes
+	range1h                         // last hour
+	rangeSinceStart                 // whole log of the running container
+)
+
+func (r logRange) label(tail int64) string {
+	switch r {
+	case range15m:
+		return "15 dernières min"
+	case range1h:
+		return "dernière heure"
+	case rangeSinceStart:
+		return "depuis le démarrage"
+	}
+	if tail <= 0 {
+		return "dernières lignes"
+	}
+	return fmt.Sprintf("%d dernières lignes", tail)
+}
+
+// options builds the snapshot options of the range. Lines always come with
+// their server timestamp, so that the gutter can be toggled without
+// refetching; snapshots are capped by limit_bytes.
+func (r logRange) options(tail int64, cfg config.LogsConfig) domain.LogOptions {
+	opts := domain.LogOptions{TailLines: -1, LimitBytes: cfg.LimitBytes, Timestamps: true}
+	switch r {
+	case rangeTail:
+		opts.TailLines = tail
+	case range15m:
+		opts.SinceSeconds = int64((15 * time.Minute).Seconds())
+	case range1h:
+		opts.SinceSeconds = int64(time.Hour.Seconds())
+	}
+	return opts
+}
+
+// streamOptions builds the options of a followed stream. They carry no
+// byte limit: a stream reaching it would end without notice.
+func (r logRange) streamOptions(tail int64, cfg config.LogsConfig) domain.LogOptions {
+	opts := r.options(tail, cfg)
+	opts.LimitBytes = 0
+	return opts
+}
+
+// setTimestampedContent loads a snapshot fetched with Timestamps, moving
+// the server timestamps out of the lines.
+func (ls *logState) setTimestampedContent(content string) {
+	lines := strings.Split(content, "\n")
+	times := make([]time.Time, len(lines))
+	for i, line := range lines {
+		times[i], lines[i] = domain.SplitLogTimestamp(line)
+	}
+	ls.setContent(strings.Join(lines, "\n"))
+	ls.times = times
+}
+
+// timeGutterWidth is the width of "15:04:05.000 ".
+const timeGutterWidth = 13
+
+// renderTimeGutter renders the server timestamp of a line in local time,
+// or blanks when it is unknown.
+func renderTimeGutter(ts time.Time) string {
+	if ts.IsZero() {
+		return strings.Repeat(" ", timeGutterWidth)
+	}
+	return lipgloss.NewStyle().Foreground(colorMuted).Render(ts.Local().Format("15:04:05.000")) + " "
+}
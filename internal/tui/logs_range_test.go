@@ -0,0 +1,164 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func TestLogRange_Options(t *testing.T) {
+	cfg := config.LogsConfig{TailLines: 200, LimitBytes: 1024}
+	tests := []struct {
+		rng   logRange
+		tail  int64
+		since int64
+	}{
+		{rangeTail, 200, 0},
+		{range15m, -1, 900},
+		{range1h, -1, 3600},
+		{rangeSinceStart, -1, 0},
+	}
+	for _, tt := range tests {
+		opts := tt.rng.options(200, cfg)
+		if opts.TailLines != tt.tail || opts.SinceSeconds != tt.since {
+			t.Errorf("%s: opts = %+v", tt.rng.label(200), opts)
+		}
+		if !opts.Timestamps || opts.LimitBytes != 1024 {
+			t.Errorf("%s: every snapshot needs timestamps and the byte limit", tt.rng.label(200))
+		}
+		stream := tt.rng.streamOptions(200, cfg)
+		if stream.LimitBytes != 0 || stream.TailLines != tt.tail || stream.SinceSeconds != tt.since {
+			t.Errorf("%s: stream opts = %+v, want the range without byte limit", tt.rng.label(200), stream)
+		}
+	}
+}
+
+func TestSetTimestampedContent(t *testing.T) {
+	var ls logState
+	ls.setTimestampedContent("2024-05-01T10:00:00.5Z GET /health 200\nno timestamp")
+
+	if ls.lines[0] != "GET /health 200" || ls.lines[1] != "no timestamp" {
+		t.Errorf("lines = %q, want timestamps moved out", ls.lines)
+	}
+	if ls.times[0].IsZero() || !ls.times[1].IsZero() {
+		t.Errorf("times = %v", ls.times)
+	}
+}
+
+func TestRenderLogs_TimeGutter(t *testing.T) {
+	ls := logState{podName: "web-1", tailLines: 200}
+	ls.setTimestampedContent("2024-05-01T10:00:00.5Z started\nplain")
+
+	output := renderLogs(&ls, 80, 10)
+	if !strings.Contains(output, "200 dernières lignes") {
+		t.Errorf("header = %q, want the range", output)
+	}
+	if strings.Contains(output, ".500 ") {
+		t.Error("gutter should be hidden by default")
+	}
+
+	ls.showTimes = true
+	output = renderLogs(&ls, 80, 10)
+	want := time.Date(2024, 5, 1, 10, 0, 0, 5e8, time.UTC).Local().Format("15:04:05.000")
+	if !strings.Contains(output, want) {
+		t.Errorf("output = %q, want gutter %s", output, want)
+	}
+	if !strings.Contains(output, strings.Repeat(" ", timeGutterWidth)+"plain") {
+		t.Error("lines without timestamp keep the gutter width")
+	}
+}
+
+func TestLogState_FollowKeepsStreamTimestamps(t *testing.T) {
+	ls := logState{following: true}
+	ts := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	ls.appendLogLines([]domain.LogLine{{Timestamp: ts, Text: "a"}}, 10)
+	ls.appendLines([]string{"b"}, 10)
+
+	if len(ls.times) != 2 || !ls.times[0].Equal(ts) || !ls.times[1].IsZero() {
+		t.Errorf("times = %v, want parallel to lines", ls.times)
+	}
+}
+
+func TestRangeKey_RefetchesWithNextRange(t *testing.T) {
+	m, mock := newFollowTestModel()
+	m.logState.tailLines = 200
+
+	m, cmd := pressKey(m, "R")
+	if m.logState.rng != range15m || cmd == nil {
+		t.Fatalf("rng = %v, want 15m with a fetch", m.logState.rng)
+	}
+	mock.LogContent = "2024-05-01T10:00:00Z recent"
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+
+	if mock.LogOpts.SinceSeconds != 900 || mock.LogOpts.TailLines != -1 || !mock.LogOpts.Timestamps {
+		t.Errorf("opts = %+v, want last 15 minutes with timestamps", mock.LogOpts)
+	}
+	if m.logState.lines[0] != "recent" {
+		t.Errorf("lines = %q", m.logState.lines)
+	}
+
+	for range 3 {
+		m, _ = pressKey(m, "R")
+	}
+	if m.logState.rng != rangeTail {
+		t.Errorf("rng = %v, want back to the last lines", m.logState.rng)
+	}
+}
+
+func TestRangeKey_RestartsFollow(t *testing.T) {
+	m, mock := newFollowTestModel()
+	m, _ = pressKey(m, "f")
+	oldCh := m.logCh
+
+	m, cmd := pressKey(m, "R")
+	if !m.logState.following || m.logCh == nil || cmd == nil {
+		t.Fatal("range change should restart the stream")
+	}
+	if oldCh == nil || mock.LogOpts.SinceSeconds != 900 {
+		t.Errorf("opts = %+v, want the new range on the stream", mock.LogOpts)
+	}
+	if mock.LogOpts.LimitBytes != 0 {
+		t.Errorf("LimitBytes = %d, a followed stream should not be capped", mock.LogOpts.LimitBytes)
+	}
+}
+
+func TestRangeKey_PreviousLogs_Refused(t *testing.T) {
+	m, _ := newFollowTestModel()
+	m.logState.previous = true
+
+	m, _ = pressKey(m, "R")
+	if m.logState.rng != rangeTail || m.toast.level != toastError {
+		t.Errorf("rng = %v toast = %q", m.logState.rng, m.toast.message)
+	}
+}
+
+func TestTimesKey_TogglesGutter(t *testing.T) {
+	m, mock := newFollowTestModel()
+	calls := mock.LogOpts
+
+	m, cmd := pressKey(m, "T")
+	if !m.logState.showTimes || cmd != nil {
+		t.Error("T should show the gutter without refetching")
+	}
+	if mock.LogOpts != calls {
+		t.Error("no log request expected")
+	}
+}
+
+func TestRefreshKey_RefetchesSnapshot(t *testing.T) {
+	m, mock := newFollowTestModel()
+	mock.LogContent = "fresh"
+
+	m, cmd := pressKey(m, "r")
+	if cmd == nil {
+		t.Fatal("r should refetch the logs")
+	}
+	updated, _ := m.Update(cmd())
+	if got := updated.(Model).logState.lines[0]; got != "fresh" {
+		t.Errorf("lines[0] = %q, want fresh", got)
+	}
+}
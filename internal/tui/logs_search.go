@@ -0,0 +1,148 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// compileLogSearch compiles a log search pattern. The search is
+// case-insensitive unless the pattern contains an uppercase letter.
+func compileLogSearch(pattern string) (*regexp.Regexp, error) {
+	if !strings.ContainsFunc(pattern, unicode.IsUpper) {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("regex invalide : %w", err)
+	}
+	return re, nil
+}
+
+// setSearch starts a search and moves to the first match at or below the
+// top of the screen, wrapping around to the first one.
+func (ls *logState) setSearch(pattern string, re *regexp.Regexp, viewHeight int) {
+	ls.searchPattern = pattern
+	ls.search = re
+	ls.matches = nil
+	ls.matchIdx = 0
+	ls.updateMatches(0)
+	if len(ls.matches) == 0 {
+		return
+	}
+	ls.matchIdx = sort.SearchInts(ls.matches, ls.offset) % len(ls.matches)
+	ls.showLine(ls.matches[ls.matchIdx], viewHeight)
+}
+
+func (ls *logState) clearSearch() {
+	ls.searchPattern = ""
+	ls.search = nil
+	ls.matches = nil
+	ls.matchIdx = 0
+}
+
+// updateMatches rescans the buffer after lines changed. shift is the number
+// of lines dropped from the top, so the current match stays selected.
+func (ls *logState) updateMatches(shift int) {
+	if ls.search == nil {
+		return
+	}
+	current := -1
+	if ls.matchIdx < len(ls.matches) {
+		current = ls.matches[ls.matchIdx] - shift
+	}
+	ls.matches = ls.matches[:0]
+	for v := range ls.visibleCount() {
+		if ls.search.MatchString(ls.lines[ls.lineAt(v)]) {
+			ls.matches = append(ls.matches, v)
+		}
+	}
+	ls.matchIdx = 0
+	if current >= 0 && len(ls.matches) > 0 {
+		ls.matchIdx = min(sort.SearchInts(ls.matches, current), len(ls.matches)-1)
+	}
+}
+
+// nextMatch moves to the next (dir > 0) or previous match, wrapping around.
+func (ls *logState) nextMatch(dir, viewHeight int) {
+	n := len(ls.matches)
+	if n == 0 {
+		return
+	}
+	ls.matchIdx = ((ls.matchIdx+dir)%n + n) % n
+	ls.showLine(ls.matches[ls.matchIdx], viewHeight)
+}
+
+// showLine scrolls just enough for line to be visible, a third of the way
+// down the screen when it was off-screen.
+func (ls *logState) showLine(line, viewHeight int) {
+	if line >= ls.offset && line < ls.windowEnd(viewHeight) {
+		return
+	}
+	maxOffset := ls.maxOffset(viewHeight)
+	ls.offset = min(max(line-viewHeight/3, 0), maxOffset)
+	ls.paused = ls.following && ls.offset < maxOffset
+}
+
+func (ls *logState) currentMatchLine() int {
+	if ls.matchIdx < len(ls.matches) {
+		return ls.matches[ls.matchIdx]
+	}
+	return -1
+}
+
+// searchStatus is the match counter shown in the log header.
+func (ls *logState) searchStatus() string {
+	if ls.search == nil {
+		return ""
+	}
+	if len(ls.matches) == 0 {
+		return fmt.Sprintf(" [/%s : aucun résultat]", ls.searchPattern)
+	}
+	return fmt.Sprintf(" [/%s : %d/%d]", ls.searchPattern, ls.matchIdx+1, len(ls.matches))
+}
+
+// highlightMatches colorizes a log line and highlights every match of re.
+// Text between matches keeps the colorizeLine styling.
+func highlightMatches(line string, re *regexp.Regexp, current bool) string {
+	if re == nil {
+		return colorizeLine(line)
+	}
+	return highlightRanges(line, 0, matchRanges(line, re), current)
+}
+
+// matchRanges lists the byte ranges of the non-empty matches of re.
+func matchRanges(line string, re *regexp.Regexp) [][]int {
+	var ranges [][]int
+	for _, loc := range re.FindAllStringIndex(line, -1) {
+		if loc[0] != loc[1] {
+			ranges = append(ranges, loc)
+		}
+	}
+	return ranges
+}
+
+// highlightRanges renders the part of a line starting at byte start,
+// highlighting the ranges, taken from the whole line, that fall within it.
+// A match cut by a wrap is thus highlighted on both visual lines.
+func highlightRanges(chunk string, start int, ranges [][]int, current bool) string {
+	style := searchMatchStyle
+	if current {
+		style = searchCurrentStyle
+	}
+	var b strings.Builder
+	last := 0
+	for _, r := range ranges {
+		lo, hi := max(r[0]-start, last), min(r[1]-start, len(chunk))
+		if lo >= hi {
+			continue
+		}
+		b.WriteString(colorizeLine(chunk[last:lo]))
+		b.WriteString(style.Render(chunk[lo:hi]))
+		last = hi
+	}
+	b.WriteString(colorizeLine(chunk[last:]))
+	return b.String()
+}
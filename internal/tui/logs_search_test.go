@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func newSearchLogState(lines ...string) logState {
+	ls := logState{podName: "web-1"}
+	ls.setContent(strings.Join(lines, "\n"))
+	return ls
+}
+
+func TestCompileLogSearch_SmartCase(t *testing.T) {
+	re, err := compileLogSearch("error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !re.MatchString("ERROR boom") {
+		t.Error("lowercase pattern should be case-insensitive")
+	}
+	re, _ = compileLogSearch("Error")
+	if re.MatchString("ERROR boom") {
+		t.Error("pattern with uppercase should be case-sensitive")
+	}
+	if _, err := compileLogSearch("a("); err == nil || !strings.Contains(err.Error(), "regex invalide") {
+		t.Errorf("err = %v, want regex invalide", err)
+	}
+}
+
+func TestLogState_SetSearch_JumpsToFirstMatchBelowOffset(t *testing.T) {
+	lines := make([]string, 50)
+	for i := range lines {
+		lines[i] = "ok"
+	}
+	lines[3], lines[30], lines[45] = "timeout", "timeout", "timeout"
+	ls := newSearchLogState(lines...)
+	ls.offset = 20
+
+	re, _ := compileLogSearch("time")
+	ls.setSearch("time", re, 10)
+
+	if len(ls.matches) != 3 || ls.matchIdx != 1 {
+		t.Fatalf("matches = %v idx = %d, want 3 matches, current 30", ls.matches, ls.matchIdx)
+	}
+	if ls.offset != 27 {
+		t.Errorf("offset = %d, want 27 (match a third down the screen)", ls.offset)
+	}
+	if got := ls.searchStatus(); got != " [/time : 2/3]" {
+		t.Errorf("status = %q", got)
+	}
+}
+
+func TestLogState_NextMatch_Wraps(t *testing.T) {
+	lines := make([]string, 100)
+	lines[10], lines[90] = "hit", "hit"
+	ls := newSearchLogState(lines...)
+	re, _ := compileLogSearch("hit")
+	ls.setSearch("hit", re, 10)
+
+	ls.nextMatch(1, 10)
+	if ls.currentMatchLine() != 90 {
+		t.Fatalf("current = %d, want 90", ls.currentMatchLine())
+	}
+	if ls.offset != 87 {
+		t.Errorf("offset = %d, want 87 (match a third down the screen)", ls.offset)
+	}
+	ls.nextMatch(1, 10)
+	if ls.currentMatchLine() != 10 {
+		t.Errorf("current = %d, want 10 after wrapping", ls.currentMatchLine())
+	}
+	ls.nextMatch(-1, 10)
+	if ls.currentMatchLine() != 90 {
+		t.Errorf("current = %d, want 90 after wrapping backwards", ls.currentMatchLine())
+	}
+}
+
+func TestLogState_Search_NoMatch(t *testing.T) {
+	ls := newSearchLogState("a", "b")
+	re, _ := compileLogSearch("zzz")
+	ls.setSearch("zzz", re, 10)
+	ls.nextMatch(1, 10)
+
+	if !strings.Contains(ls.searchStatus(), "aucun résultat") {
+		t.Errorf("status = %q", ls.searchStatus())
+	}
+}
+
+func TestLogState_Search_FollowKeepsCurrentMatch(t *testing.T) {
+	ls := logState{following: true, paused: true}
+	ls.appendLines(make([]string, maxFollowLines-2), 10)
+	ls.appendLines([]string{"hit one", "hit two"}, 10)
+	re, _ := compileLogSearch("hit")
+	ls.setSearch("hit", re, 10) // current: "hit one"
+
+	ls.appendLines([]string{"x", "hit three"}, 10)
+
+	if len(ls.matches) != 3 {
+		t.Fatalf("matches = %v, want 3", ls.matches)
+	}
+	if ls.lines[ls.currentMatchLine()] != "hit one" {
+		t.Errorf("current = %q, want hit one after dropping old lines", ls.lines[ls.currentMatchLine()])
+	}
+}
+
+func TestHighlightMatches(t *testing.T) {
+	re, _ := compileLogSearch("db")
+	got := highlightMatches("connect db failed, db down", re, false)
+	if strings.Count(got, searchMatchStyle.Render("db")) != 2 {
+		t.Errorf("got %q, want both matches highlighted", got)
+	}
+	if highlightMatches("plain", nil, false) != colorizeLine("plain") {
+		t.Error("without a search the line is only colorized")
+	}
+}
+
+func TestRenderLogs_HighlightAcrossWrap(t *testing.T) {
+	saved := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	t.Cleanup(func() { lipgloss.SetColorProfile(saved) })
+
+	// 20 columns leave 18 per visual line: "needle" is cut after "nee"
+	ls := newSearchLogState(strings.Repeat("x", 15) + "needle")
+	ls.wrap = true
+	re, _ := compileLogSearch("needle")
+	ls.setSearch("needle", re, 10)
+
+	output := renderLogs(&ls, 20, 10)
+	style := searchCurrentStyle
+	if !strings.Contains(output, style.Render("nee")) || !strings.Contains(output, style.Render("dle")) {
+		t.Errorf("both halves of the match should be highlighted:\n%q", output)
+	}
+}
+
+func TestRenderLogs_SearchCounterInHeader(t *testing.T) {
+	ls := newSearchLogState("GET /health 200", "POST /login 500")
+	re, _ := compileLogSearch("login")
+	ls.setSearch("login", re, 10)
+
+	output := renderLogs(&ls, 80, 10)
+	if !strings.Contains(output, "[/login : 1/1]") {
+		t.Errorf("output = %q, want match counter", output)
+	}
+}
+
+func TestSearchKey_PromptsAndEscapeClears(t *testing.T) {
+	m, _ := newFollowTestModel()
+	m.logState.setContent("alpha\nbeta\nalpha")
+
+	m, _ = pressKey(m, "/")
+	if !m.logSearching {
+		t.Fatal("/ should open the search prompt in the logs view")
+	}
+	m = typeText(m, "alpha")
+	m, _ = pressKey(m, "enter")
+	if m.logSearching || len(m.logState.matches) != 2 {
+		t.Fatalf("searching = %v matches = %v", m.logSearching, m.logState.matches)
+	}
+
+	m, _ = pressKey(m, "n")
+	if m.logState.currentMatchLine() != 2 {
+		t.Errorf("n: current = %d, want 2", m.logState.currentMatchLine())
+	}
+
+	m, _ = pressKey(m, "esc")
+	if m.view != ViewLogs || m.logState.search != nil {
+		t.Error("first esc should clear the search and stay in the logs view")
+	}
+	m, _ = pressKey(m, "esc")
+	if m.view != ViewPods {
+		t.Error("second esc should leave the logs view")
+	}
+}
+
+func TestSearchKey_InvalidRegexKeepsPrompt(t *testing.T) {
+	m, _ := newFollowTestModel()
+
+	m, _ = pressKey(m, "/")
+	m = typeText(m, "a(")
+	m, _ = pressKey(m, "enter")
+
+	if !m.logSearching {
+		t.Error("prompt should stay open on an invalid regex")
+	}
+	if m.toast.level != toastError {
+		t.Errorf("toast = %q, want error", m.toast.message)
+	}
+}
@@ -0,0 +1,447 @@
+package tui
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxPaletteHistory caps the ":" commands kept between sessions, and
+// maxPaletteSuggestions the completions shown under the prompt.
+const (
+	maxPaletteHistory     = 100
+	maxPaletteSuggestions = 8
+)
+
+// paletteCommand is a ":" command. Commands with args complete their
+// argument: namespaces for ns, contexts for ctx.
+type paletteCommand struct {
+	name    string
+	aliases []string
+	args    string
+}
+
+var paletteCommands = []paletteCommand{
+	{name: "overview", aliases: []string{"ov"}},
+	{name: "projects", aliases: []string{"proj"}},
+	{name: "pods", aliases: []string{"po", "pod"}},
+	{name: "deploy", aliases: []string{"dp", "deployments"}},
+	{name: "events", aliases: []string{"ev"}},
+	{name: "contexts"},
+	{name: "ns", args: "<namespace>"},
+	{name: "ctx", args: "<contexte>"},
+	{name: "all"},
+	{name: "logs"},
+	{name: "yaml"},
+	{name: "shell", aliases: []string{"sh"}},
+	{name: "run"},
+	{name: "debug"},
+	{name: "delete", aliases: []string{"del"}},
+	{name: "scale", args: "<replicas>"},
+	{name: "quit", aliases: []string{"q"}},
+}
+
+// paletteState is the ":" command prompt. Namespaces and contexts are
+// listed when it opens, for completion.
+type paletteState struct {
+	active     bool
+	input      textinput.Model
+	history    []string // most recent first
+	historyIdx int      // -1 when not browsing history
+	loading    bool     // history file being read
+	loaded     bool     // history read from disk, it may be saved
+	namespaces []string
+	contexts   []string
+}
+
+// paletteDataMsg carries the completion lists.
+type paletteDataMsg struct {
+	namespaces []string
+	contexts   []string
+}
+
+// paletteHistoryMsg carries the history read from disk on the first
+// opening. It comes apart from the completion lists, which wait on the API.
+type paletteHistoryMsg struct{ history []string }
+
+func newPaletteInput() textinput.Model {
+	pi := textinput.New()
+	pi.Prompt = ":"
+	pi.Placeholder = "pods, deploy, ns <namespace>, ctx <contexte>..."
+	pi.CharLimit = 128
+	pi.Width = 50
+	return pi
+}
+
+func (m Model) openPalette() (tea.Model, tea.Cmd) {
+	m.palette.active = true
+	m.palette.historyIdx = -1
+	m.palette.input.SetValue("")
+	m.palette.input.Focus()
+
+	var loadHistory tea.Cmd
+	if !m.palette.loaded && !m.palette.loading {
+		m.palette.loading = true
+		path := expandHome(m.cfg.Palette.HistoryFile)
+		loadHistory = func() tea.Msg {
+			return paletteHistoryMsg{loadPaletteHistory(path)}
+		}
+	}
+	client := m.client
+	return m, tea.Batch(textinput.Blink, loadHistory, m.inContext(func() tea.Msg {
+		var msg paletteDataMsg
+		if nss, err := client.ListNamespaces(context.Background()); err == nil {
+			for _, ns := range nss {
+				msg.namespaces = append(msg.namespaces, ns.Name)
+			}
+		}
+		if ctxs, err := client.ListContexts(); err == nil {
+			for _, c := range ctxs {
+				msg.contexts = append(msg.contexts, c.Name)
+			}
+		}
+		return msg
+	}))
+}
+
+func (m Model) handlePaletteData(msg paletteDataMsg) Model {
+	m.palette.namespaces = msg.namespaces
+	m.palette.contexts = msg.contexts
+	return m
+}
+
+// handlePaletteHistory puts the commands run before the history was read
+// on top of it, then saves the result if there were any.
+func (m Model) handlePaletteHistory(msg paletteHistoryMsg) (Model, tea.Cmd) {
+	session := m.palette.history
+	history := msg.history
+	for i := len(session) - 1; i >= 0; i-- {
+		history = pushHistory(history, session[i], maxPaletteHistory)
+	}
+	m.palette.history = history
+	m.palette.loading = false
+	m.palette.loaded = true
+	if len(session) == 0 {
+		return m, nil
+	}
+	return m, savePaletteHistory(expandHome(m.cfg.Palette.HistoryFile), history)
+}
+
+func (m Model) handlePaletteInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	history := m.palette.history
+	switch msg.String() {
+	case "esc":
+		m.palette.active = false
+		m.palette.input.Blur()
+		m.palette.input.SetValue("")
+		return m, nil
+	case "tab":
+		line, _ := m.palette.complete(m.palette.input.Value())
+		m.palette.input.SetValue(line)
+		m.palette.input.CursorEnd()
+		return m, nil
+	case "up":
+		if m.palette.historyIdx+1 < len(history) {
+			m.palette.historyIdx++
+			m.palette.input.SetValue(history[m.palette.historyIdx])
+			m.palette.input.CursorEnd()
+		}
+		return m, nil
+	case "down":
+		if m.palette.historyIdx > 0 {
+			m.palette.historyIdx--
+			m.palette.input.SetValue(history[m.palette.historyIdx])
+			m.palette.input.CursorEnd()
+		} else {
+			m.palette.historyIdx = -1
+			m.palette.input.SetValue("")
+		}
+		return m, nil
+	case "enter":
+		line := strings.Join(strings.Fields(m.palette.input.Value()), " ")
+		m.palette.active = false
+		m.palette.input.Blur()
+		m.palette.input.SetValue("")
+		if line == "" {
+			return m, nil
+		}
+		var save tea.Cmd
+		if _, ok := lookupPaletteCommand(strings.Fields(line)[0]); ok {
+			m.palette.history = pushHistory(history, line, maxPaletteHistory)
+			// Saving before the file was read would overwrite it
+			if m.palette.loaded {
+				save = savePaletteHistory(expandHome(m.cfg.Palette.HistoryFile), m.palette.history)
+			}
+		}
+		updated, cmd := m.runPalette(line)
+		return updated, tea.Batch(cmd, save)
+	default:
+		var cmd tea.Cmd
+		m.palette.input, cmd = m.palette.input.Update(msg)
+		return m, cmd
+	}
+}
+
+func lookupPaletteCommand(word string) (paletteCommand, bool) {
+	word = strings.ToLower(word)
+	for _, c := range paletteCommands {
+		if c.name == word || slices.Contains(c.aliases, word) {
+			return c, true
+		}
+	}
+	return paletteCommand{}, false
+}
+
+// runPalette executes a command line: view switches, namespace and context
+// changes, or actions on the selected row of the current view.
+func (m Model) runPalette(line string) (tea.Model, tea.Cmd) {
+	words := strings.Fields(line)
+	cmd, ok := lookupPaletteCommand(words[0])
+	if !ok {
+		m.toast = newToast(fmt.Sprintf("Commande inconnue : %s", words[0]), toastError)
+		return m, scheduleToastClear()
+	}
+	arg := strings.Join(words[1:], " ")
+
+	unavailable := func() (tea.Model, tea.Cmd) {
+		m.toast = newToast(fmt.Sprintf("':%s' indisponible dans la vue %s", cmd.name, m.view), toastError)
+		return m, scheduleToastClear()
+	}
+	onPods := m.view == ViewPods
+	onList := m.view == ViewPods || m.view == ViewDeployments
+
+	switch cmd.name {
+	case "overview":
+		return m.switchView(ViewOverview)
+	case "projects":
+		return m.switchView(ViewProjects)
+	case "pods":
+		return m.switchView(ViewPods)
+	case "deploy":
+		return m.switchView(ViewDeployments)
+	case "events":
+		return m.switchView(ViewEvents)
+	case "contexts":
+		return m.switchView(ViewContexts)
+	case "ns":
+		if arg == "" {
+			return m.switchView(ViewProjects)
+		}
+		return m.switchNamespace(arg)
+	case "ctx":
+		if arg == "" {
+			return m.switchView(ViewContexts)
+		}
+		return m.switchContextTo(arg)
+	case "all":
+		if !onList && m.view != ViewEvents {
+			return unavailable()
+		}
+		return m.toggleAllNamespaces()
+	case "logs":
+		if !onList {
+			return unavailable()
+		}
+		return m.handleEnter()
+	case "yaml":
+		if !onList {
+			return unavailable()
+		}
+		return m.handleYAML()
+	case "shell":
+		if !onPods {
+			return unavailable()
+		}
+		return m.handleExecPod()
+	case "run":
+		if !onPods {
+			return unavailable()
+		}
+		return m.handleRunCommand()
+	case "debug":
+		if !onPods {
+			return unavailable()
+		}
+		return m.handleDebugPod()
+	case "delete":
+		if !onPods {
+			return unavailable()
+		}
+		return m.handleDeletePod()
+	case "scale":
+		if m.view != ViewDeployments {
+			return unavailable()
+		}
+		replicas, err := strconv.Atoi(arg)
+		if err != nil || replicas < 0 {
+			m.toast = newToast("Nombre invalide", toastError)
+			return m, scheduleToastClear()
+		}
+		items := m.filteredDeployments()
+		if m.cursor >= len(items) {
+			return m, nil
+		}
+		m.targetRow(items[m.cursor].Namespace)
+		return m.scaleTo(items[m.cursor].Name, int32(replicas))
+	case "quit":
+		return m.quit()
+	}
+	return m, nil
+}
+
+// complete extends the word under completion to the longest prefix shared
+// by its candidates, and returns them. A single candidate is completed with
+// a trailing space when an argument may follow.
+func (ps *paletteState) complete(line string) (string, []string) {
+	words := strings.Fields(line)
+	newWord := line == "" || strings.HasSuffix(line, " ")
+
+	if len(words) == 0 || (len(words) == 1 && !newWord) {
+		prefix := ""
+		if len(words) == 1 {
+			prefix = strings.ToLower(words[0])
+		}
+		var names []string
+		for _, c := range paletteCommands {
+			if strings.HasPrefix(c.name, prefix) {
+				names = append(names, c.name)
+			}
+		}
+		if len(names) == 1 {
+			if c, _ := lookupPaletteCommand(names[0]); c.args != "" {
+				return names[0] + " ", names
+			}
+			return names[0], names
+		}
+		if common := commonPrefix(names); len(common) > len(prefix) {
+			return common, names
+		}
+		return line, names
+	}
+
+	cmd, ok := lookupPaletteCommand(words[0])
+	if !ok || (len(words) > 2 || (len(words) == 2 && newWord)) {
+		return line, nil
+	}
+	var choices []string
+	switch cmd.name {
+	case "ns":
+		choices = ps.namespaces
+	case "ctx":
+		choices = ps.contexts
+	default:
+		return line, nil
+	}
+	prefix := ""
+	if len(words) == 2 {
+		prefix = words[1]
+	}
+	var matches []string
+	for _, c := range choices {
+		if strings.HasPrefix(c, prefix) {
+			matches = append(matches, c)
+		}
+	}
+	sort.Strings(matches)
+	if common := commonPrefix(matches); len(common) > len(prefix) {
+		return cmd.name + " " + common, matches
+	}
+	return line, matches
+}
+
+func commonPrefix(words []string) string {
+	if len(words) == 0 {
+		return ""
+	}
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+func renderPalette(ps *paletteState) string {
+	var b strings.Builder
+	b.WriteString("  " + ps.input.View() + "\n")
+	_, suggestions := ps.complete(ps.input.Value())
+	if len(suggestions) == 0 {
+		return b.String()
+	}
+	more := ""
+	if len(suggestions) > maxPaletteSuggestions {
+		more = fmt.Sprintf("  (+%d)", len(suggestions)-maxPaletteSuggestions)
+		suggestions = suggestions[:maxPaletteSuggestions]
+	}
+	line := strings.Join(suggestions, "  ")
+	if len(suggestions) == 1 {
+		if c, ok := lookupPaletteCommand(suggestions[0]); ok && c.args != "" {
+			line += " " + c.args
+		}
+	}
+	b.WriteString("   " + lipgloss.NewStyle().Foreground(colorMuted).Render(line+more) + "\n")
+	return b.String()
+}
+
+// pushHistory adds line to the front of history, dropping duplicates and
+// keeping at most limit entries.
+func pushHistory(history []string, line string, limit int) []string {
+	result := []string{line}
+	for _, h := range history {
+		if h != line && len(result) < limit {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// loadPaletteHistory reads the history file, oldest command first, and
+// returns the most recent first. A missing file is an empty history.
+func loadPaletteHistory(path string) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var history []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			history = pushHistory(history, line, maxPaletteHistory)
+		}
+	}
+	return history
+}
+
+// savePaletteHistory writes the history, oldest command first. Failing to
+// save it is not reported: the command ran anyway.
+func savePaletteHistory(path string, history []string) tea.Cmd {
+	if path == "" {
+		return nil
+	}
+	lines := slices.Clone(history)
+	slices.Reverse(lines)
+	return func() tea.Msg {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil
+		}
+		var b strings.Builder
+		for _, l := range lines {
+			b.WriteString(l + "\n")
+		}
+		_ = os.WriteFile(path, []byte(b.String()), 0o600)
+		return nil
+	}
+}
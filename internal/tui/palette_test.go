@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newPaletteTestModel(t *testing.T, mock *domain.MockGateway) Model {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	cfg.Palette.HistoryFile = filepath.Join(t.TempDir(), "history")
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.width = 120
+	m.height = 30
+	return m
+}
+
+// runPaletteLine opens the prompt, types line and runs it, applying the
+// completion data and the history save on the way.
+func runPaletteLine(t *testing.T, m Model, line string) (Model, tea.Cmd) {
+	t.Helper()
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	m = updated.(Model)
+	if !m.palette.active {
+		t.Fatal(": should open the command prompt")
+	}
+	for _, msg := range batchMsgs(cmd) {
+		switch msg.(type) {
+		case ctxMsg, paletteHistoryMsg:
+			updated, _ = m.Update(msg)
+			m = updated.(Model)
+		}
+	}
+	m = typeText(m, line)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(Model), cmd
+}
+
+// batchMsgs runs cmd and, for a batch, each of its cmds.
+func batchMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		if c != nil {
+			msgs = append(msgs, c())
+		}
+	}
+	return msgs
+}
+
+func TestPaletteComplete(t *testing.T) {
+	ps := paletteState{
+		namespaces: []string{"team-a", "team-b", "other"},
+		contexts:   []string{"staging", "prod"},
+	}
+	tests := []struct {
+		line, want string
+		n          int
+	}{
+		{"po", "pods", 1},
+		{"de", "de", 3}, // deploy, debug, delete
+		{"n", "ns ", 1}, // argument follows
+		{"ns te", "ns team-", 2},
+		{"ns ", "ns ", 3},
+		{"ctx st", "ctx staging", 1},
+		{"pods x", "pods x", 0},
+	}
+	for _, tt := range tests {
+		got, candidates := ps.complete(tt.line)
+		if got != tt.want || len(candidates) != tt.n {
+			t.Errorf("complete(%q) = %q, %v; want %q with %d candidates", tt.line, got, candidates, tt.want, tt.n)
+		}
+	}
+}
+
+func TestPalette_SwitchesViewAndNamespace(t *testing.T) {
+	mock := &domain.MockGateway{NamespaceVal: "default"}
+	m := newPaletteTestModel(t, mock)
+
+	m, _ = runPaletteLine(t, m, "deploy")
+	if m.view != ViewDeployments || m.palette.active {
+		t.Errorf("view = %v, active = %v, want ViewDeployments and the prompt closed", m.view, m.palette.active)
+	}
+
+	m, _ = runPaletteLine(t, m, "ns team-a")
+	if mock.NamespaceVal != "team-a" || m.view != ViewPods {
+		t.Errorf("ns = %q, view = %v, want the pods of team-a", mock.NamespaceVal, m.view)
+	}
+
+	m, _ = runPaletteLine(t, m, "nope")
+	if !strings.Contains(m.toast.message, "Commande inconnue") {
+		t.Errorf("toast = %q, want an unknown command error", m.toast.message)
+	}
+}
+
+func TestPalette_ActionOnSelection(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Deployments:  []domain.DeploymentInfo{{Name: "web", Replicas: 1}},
+	}
+	m := newPaletteTestModel(t, mock)
+	m.view = ViewDeployments
+	m.deployments = mock.Deployments
+
+	m, cmd := runPaletteLine(t, m, "scale 3")
+	batchMsgs(cmd)
+	if mock.ScaledDep != "web" || mock.ScaledTo != 3 {
+		t.Errorf("scaled %q to %d, want web to 3", mock.ScaledDep, mock.ScaledTo)
+	}
+
+	m, _ = runPaletteLine(t, m, "shell")
+	if !strings.Contains(m.toast.message, "indisponible") {
+		t.Errorf("toast = %q, want shell unavailable on deployments", m.toast.message)
+	}
+}
+
+func TestPalette_HistoryPersisted(t *testing.T) {
+	mock := &domain.MockGateway{NamespaceVal: "default"}
+	m := newPaletteTestModel(t, mock)
+	path := m.cfg.Palette.HistoryFile
+
+	m, cmd := runPaletteLine(t, m, "events")
+	batchMsgs(cmd)
+	m, cmd = runPaletteLine(t, m, "pods")
+	batchMsgs(cmd)
+
+	if got := loadPaletteHistory(path); len(got) != 2 || got[0] != "pods" || got[1] != "events" {
+		t.Fatalf("history file = %v, want [pods events]", got)
+	}
+
+	// A new session reads it back
+	m2 := newPaletteTestModel(t, mock)
+	m2.cfg.Palette.HistoryFile = path
+	updated, cmd := m2.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	m2 = updated.(Model)
+	for _, msg := range batchMsgs(cmd) {
+		switch msg.(type) {
+		case ctxMsg, paletteHistoryMsg:
+			updated, _ = m2.Update(msg)
+			m2 = updated.(Model)
+		}
+	}
+	updated, _ = m2.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m2 = updated.(Model)
+	if m2.palette.input.Value() != "pods" {
+		t.Errorf("up = %q, want the last command of the previous session", m2.palette.input.Value())
+	}
+}
+
+func TestPalette_EnterBeforeHistoryLoaded(t *testing.T) {
+	mock := &domain.MockGateway{NamespaceVal: "default"}
+	m := newPaletteTestModel(t, mock)
+	path := m.cfg.Palette.HistoryFile
+	batchMsgs(savePaletteHistory(path, []string{"deployments", "events"}))
+
+	// Run a command before the opening Cmds come back
+	updated, open := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	m = updated.(Model)
+	m = typeText(m, "pods")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	batchMsgs(cmd)
+	if got := loadPaletteHistory(path); len(got) != 2 {
+		t.Fatalf("history file = %v, should not be saved before it is read", got)
+	}
+
+	for _, msg := range batchMsgs(open) {
+		if hist, ok := msg.(paletteHistoryMsg); ok {
+			updated, cmd = m.Update(hist)
+			m = updated.(Model)
+			batchMsgs(cmd)
+		}
+	}
+	want := []string{"pods", "deployments", "events"}
+	if got := m.palette.history; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("history = %v, want %v", got, want)
+	}
+	if got := loadPaletteHistory(path); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("history file = %v, want %v", got, want)
+	}
+}
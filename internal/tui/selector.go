@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// labelSelector is a parsed Kubernetes label selector. It supports the
+// equality (=, ==, !=), existence (key, !key) and set (in, notin) forms,
+// which covers the selectors rendered for deployments.
+type labelSelector []selectorRequirement
+
+type selectorRequirement struct {
+	key    string
+	op     string // "=", "!=", "exists", "!exists", "in", "notin"
+	values []string
+}
+
+var reSetRequirement = regexp.MustCompile(`^([^\s!=]+)\s+(in|notin)\s+\(([^)]*)\)$`)
+
+func parseLabelSelector(s string) (labelSelector, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("sélecteur vide")
+	}
+
+	var sel labelSelector
+	for _, term := range splitSelectorTerms(s) {
+		term = strings.TrimSpace(term)
+		var req selectorRequirement
+		switch {
+		case term == "":
+			return nil, fmt.Errorf("sélecteur invalide : %q", s)
+		case reSetRequirement.MatchString(term):
+			m := reSetRequirement.FindStringSubmatch(term)
+			req = selectorRequirement{key: m[1], op: m[2]}
+			for _, v := range strings.Split(m[3], ",") {
+				req.values = append(req.values, strings.TrimSpace(v))
+			}
+		case strings.HasPrefix(term, "!"):
+			req = selectorRequirement{key: strings.TrimSpace(term[1:]), op: "!exists"}
+		case strings.Contains(term, "!="):
+			k, v, _ := strings.Cut(term, "!=")
+			req = selectorRequirement{key: strings.TrimSpace(k), op: "!=", values: []string{strings.TrimSpace(v)}}
+		case strings.Contains(term, "="):
+			k, v, _ := strings.Cut(term, "=")
+			v = strings.TrimPrefix(v, "=")
+			req = selectorRequirement{key: strings.TrimSpace(k), op: "=", values: []string{strings.TrimSpace(v)}}
+		default:
+			req = selectorRequirement{key: term, op: "exists"}
+		}
+		if req.key == "" || strings.ContainsAny(req.key, " ()") {
+			return nil, fmt.Errorf("sélecteur invalide : %q", term)
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+// splitSelectorTerms splits on commas outside of parentheses.
+func splitSelectorTerms(s string) []string {
+	var terms []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, s[start:])
+}
+
+func (sel labelSelector) matches(labels map[string]string) bool {
+	for _, req := range sel {
+		v, ok := labels[req.key]
+		switch req.op {
+		case "=":
+			if !ok || v != req.values[0] {
+				return false
+			}
+		case "!=":
+			if ok && v == req.values[0] {
+				return false
+			}
+		case "exists":
+			if !ok {
+				return false
+			}
+		case "!exists":
+			if ok {
+				return false
+			}
+		case "in":
+			if !ok || !slices.Contains(req.values, v) {
+				return false
+			}
+		case "notin":
+			if ok && slices.Contains(req.values, v) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package tui
+
+import "testing"
+
+func TestParseLabelSelector_Matches(t *testing.T) {
+	labels := map[string]string{"app": "web", "tier": "front", "track": "canary"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"app=web", true},
+		{"app==web", true},
+		{"app=api", false},
+		{"app=web,tier=front", true},
+		{"app=web,tier=back", false},
+		{"app!=api", true},
+		{"app!=web", false},
+		{"track", true},
+		{"missing", false},
+		{"!missing", true},
+		{"!app", false},
+		{"track in (canary,stable)", true},
+		{"track in (stable)", false},
+		{"track notin (canary)", false},
+		{"app=web,track in (canary, stable),!missing", true},
+	}
+	for _, tt := range tests {
+		sel, err := parseLabelSelector(tt.selector)
+		if err != nil {
+			t.Errorf("parseLabelSelector(%q) error = %v", tt.selector, err)
+			continue
+		}
+		if got := sel.matches(labels); got != tt.want {
+			t.Errorf("%q matches = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestParseLabelSelector_Invalid(t *testing.T) {
+	for _, s := range []string{"", "  ", "app=web,", "=web", "a b"} {
+		if _, err := parseLabelSelector(s); err == nil {
+			t.Errorf("parseLabelSelector(%q) should fail", s)
+		}
+	}
+}
@@ -2,88 +2,155 @@ package tui
 
 import "github.com/charmbracelet/lipgloss"
 
+// The colors and styles below are derived from the active theme by
+// applyTheme; they start with the dark theme.
 var (
-	colorPrimary   = lipgloss.Color("#326CE5") // Kubernetes blue
-	colorSecondary = lipgloss.Color("#EE0000") // OKD red
-	colorSuccess   = lipgloss.Color("#04B575")
-	colorWarning   = lipgloss.Color("#FFBD2E")
-	colorError     = lipgloss.Color("#FF6B6B")
-	colorMuted     = lipgloss.Color("#626262")
-	colorHighlight = lipgloss.Color("#7D56F4")
-	colorProdBg    = lipgloss.Color("#8B0000")
-	colorWarnBg    = lipgloss.Color("#CC7700")
+	colorPrimary   lipgloss.Color // Kubernetes blue
+	colorSecondary lipgloss.Color // OKD red
+	colorSuccess   lipgloss.Color
+	colorWarning   lipgloss.Color
+	colorError     lipgloss.Color
+	colorMuted     lipgloss.Color
+	colorHighlight lipgloss.Color
+	colorProdBg    lipgloss.Color
+	colorWarnBg    lipgloss.Color
+
+	titleStyle        lipgloss.Style
+	contextStyle      lipgloss.Style
+	namespaceStyle    lipgloss.Style
+	statusBarStyle    lipgloss.Style
+	selectedStyle     lipgloss.Style
+	headerStyle       lipgloss.Style
+	tabActiveStyle    lipgloss.Style
+	tabInactiveStyle  lipgloss.Style
+	toastSuccessStyle lipgloss.Style
+	toastErrorStyle   lipgloss.Style
+	bannerWarnStyle   lipgloss.Style
+	bannerProdStyle   lipgloss.Style
+	confirmBoxStyle   lipgloss.Style
+	errorScreenStyle  lipgloss.Style
+	liveStyle         lipgloss.Style
+
+	// Log search matches; the current one stands out
+	searchMatchStyle   lipgloss.Style
+	searchCurrentStyle lipgloss.Style
+
+	eventWarningStyle       lipgloss.Style
+	eventNormalStyle        lipgloss.Style
+	timelineTransitionStyle lipgloss.Style
+	helpKeyStyle            lipgloss.Style
+	helpUnavailableStyle    lipgloss.Style
+
+	// Pod tags in aggregated logs
+	logSourceColors []lipgloss.Color
+)
+
+func init() {
+	applyTheme(darkTheme)
+}
+
+// applyTheme derives every color and style from t.
+func applyTheme(t theme) {
+	colorPrimary = t.primary
+	colorSecondary = t.secondary
+	colorSuccess = t.success
+	colorWarning = t.warning
+	colorError = t.error
+	colorMuted = t.muted
+	colorHighlight = t.highlight
+	colorProdBg = t.prodBg
+	colorWarnBg = t.warnBg
+	logSourceColors = t.logSources
 
 	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorSecondary)
+		Bold(true).
+		Foreground(colorSecondary)
 
 	contextStyle = lipgloss.NewStyle().
-			Foreground(colorPrimary).
-			Bold(true)
+		Foreground(colorPrimary).
+		Bold(true)
 
 	namespaceStyle = lipgloss.NewStyle().
-			Foreground(colorHighlight).
-			Bold(true)
+		Foreground(colorHighlight).
+		Bold(true)
 
 	statusBarStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("#333333")).
-			Foreground(lipgloss.Color("#FFFFFF")).
-			PaddingLeft(1).
-			PaddingRight(1)
+		Background(t.barBg).
+		Foreground(t.barFg).
+		PaddingLeft(1).
+		PaddingRight(1)
 
 	selectedStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("#333333")).
-			Bold(true)
+		Background(t.selectedBg).
+		Bold(true)
+	if t.selectedFg != "" {
+		selectedStyle = selectedStyle.Foreground(t.selectedFg)
+	}
 
 	headerStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorMuted).
-			Underline(true)
+		Bold(true).
+		Foreground(colorMuted).
+		Underline(true)
 
 	tabActiveStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorSecondary)
+		Bold(true).
+		Foreground(colorSecondary)
 
 	tabInactiveStyle = lipgloss.NewStyle().
-				Foreground(colorMuted)
+		Foreground(colorMuted)
 
 	toastSuccessStyle = lipgloss.NewStyle().
-				Foreground(colorSuccess).
-				Bold(true)
+		Foreground(colorSuccess).
+		Bold(true)
 
 	toastErrorStyle = lipgloss.NewStyle().
-			Foreground(colorError).
-			Bold(true)
+		Foreground(colorError).
+		Bold(true)
 
 	bannerWarnStyle = lipgloss.NewStyle().
-			Background(colorWarnBg).
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Bold(true).
-			PaddingLeft(1).
-			PaddingRight(1)
+		Background(colorWarnBg).
+		Foreground(t.bannerFg).
+		Bold(true).
+		PaddingLeft(1).
+		PaddingRight(1)
 
 	bannerProdStyle = lipgloss.NewStyle().
-			Background(colorProdBg).
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Bold(true).
-			PaddingLeft(1).
-			PaddingRight(1)
+		Background(colorProdBg).
+		Foreground(t.bannerFg).
+		Bold(true).
+		PaddingLeft(1).
+		PaddingRight(1)
 
 	confirmBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(colorWarning).
-			Padding(1, 2)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorWarning).
+		Padding(1, 2)
 
 	errorScreenStyle = lipgloss.NewStyle().
-				Foreground(colorError).
-				Bold(true).
-				PaddingLeft(2).
-				PaddingTop(1)
+		Foreground(colorError).
+		Bold(true).
+		PaddingLeft(2).
+		PaddingTop(1)
 
 	liveStyle = lipgloss.NewStyle().
-			Foreground(colorSuccess).
-			Bold(true)
-)
+		Foreground(colorSuccess).
+		Bold(true)
+
+	searchMatchStyle = lipgloss.NewStyle().
+		Background(colorWarning).
+		Foreground(t.matchFg)
+
+	searchCurrentStyle = lipgloss.NewStyle().
+		Background(colorSecondary).
+		Foreground(t.bannerFg).
+		Bold(true)
+
+	eventWarningStyle = lipgloss.NewStyle().Foreground(colorWarning)
+	eventNormalStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	timelineTransitionStyle = lipgloss.NewStyle().Foreground(colorPrimary)
+	helpKeyStyle = lipgloss.NewStyle().Foreground(colorPrimary).Bold(true)
+	helpUnavailableStyle = lipgloss.NewStyle().Foreground(colorMuted).Faint(true)
+}
 
 func colorizeStatus(status string) string {
 	switch status {
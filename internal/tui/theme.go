@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Theme names accepted by the theme setting of config.yaml.
+const (
+	ThemeAuto         = "auto" // dark or light after the terminal background
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeANSI16       = "ansi16"
+)
+
+// theme is the palette the styles are derived from.
+type theme struct {
+	name                                 string
+	primary, secondary, highlight        lipgloss.Color
+	success, warning, error, muted       lipgloss.Color
+	prodBg, warnBg                       lipgloss.Color
+	barBg, barFg, selectedBg, selectedFg lipgloss.Color
+	bannerFg, matchFg                    lipgloss.Color
+	logSources                           []lipgloss.Color
+	ansi                                 bool // only uses the 16 base colors
+}
+
+// darkTheme follows the colors of the spec (4.5).
+var darkTheme = theme{
+	name:       ThemeDark,
+	primary:    lipgloss.Color("#326CE5"),
+	secondary:  lipgloss.Color("#EE0000"),
+	highlight:  lipgloss.Color("#7D56F4"),
+	success:    lipgloss.Color("#04B575"),
+	warning:    lipgloss.Color("#FFBD2E"),
+	error:      lipgloss.Color("#FF6B6B"),
+	muted:      lipgloss.Color("#626262"),
+	prodBg:     lipgloss.Color("#8B0000"),
+	warnBg:     lipgloss.Color("#CC7700"),
+	barBg:      lipgloss.Color("#333333"),
+	barFg:      lipgloss.Color("#FFFFFF"),
+	selectedBg: lipgloss.Color("#333333"),
+	bannerFg:   lipgloss.Color("#FFFFFF"),
+	matchFg:    lipgloss.Color("#000000"),
+	logSources: []lipgloss.Color{
+		"#326CE5", "#04B575", "#FFBD2E", "#7D56F4",
+		"#00B5D8", "#E056FD", "#A3CB38", "#FF9F43",
+	},
+}
+
+// lightTheme darkens the colors that would fade on a light background.
+var lightTheme = theme{
+	name:       ThemeLight,
+	primary:    lipgloss.Color("#1F4FBF"),
+	secondary:  lipgloss.Color("#CC0000"),
+	highlight:  lipgloss.Color("#5A32D6"),
+	success:    lipgloss.Color("#027A4A"),
+	warning:    lipgloss.Color("#A86400"),
+	error:      lipgloss.Color("#C62828"),
+	muted:      lipgloss.Color("#707070"),
+	prodBg:     lipgloss.Color("#B00000"),
+	warnBg:     lipgloss.Color("#CC7700"),
+	barBg:      lipgloss.Color("#D8D8D8"),
+	barFg:      lipgloss.Color("#000000"),
+	selectedBg: lipgloss.Color("#E4E4E4"),
+	bannerFg:   lipgloss.Color("#FFFFFF"),
+	matchFg:    lipgloss.Color("#FFFFFF"),
+	logSources: []lipgloss.Color{
+		"#1F4FBF", "#027A4A", "#A86400", "#5A32D6",
+		"#007A99", "#A020A0", "#4F7A00", "#C05600",
+	},
+}
+
+// highContrastTheme uses saturated colors and a selection that does not
+// rely on a subtle background.
+var highContrastTheme = theme{
+	name:       ThemeHighContrast,
+	primary:    lipgloss.Color("#00AFFF"),
+	secondary:  lipgloss.Color("#FF0000"),
+	highlight:  lipgloss.Color("#FF00FF"),
+	success:    lipgloss.Color("#00FF00"),
+	warning:    lipgloss.Color("#FFFF00"),
+	error:      lipgloss.Color("#FF3030"),
+	muted:      lipgloss.Color("#C0C0C0"),
+	prodBg:     lipgloss.Color("#D70000"),
+	warnBg:     lipgloss.Color("#D75F00"),
+	barBg:      lipgloss.Color("#FFFFFF"),
+	barFg:      lipgloss.Color("#000000"),
+	selectedBg: lipgloss.Color("#FFFF00"),
+	selectedFg: lipgloss.Color("#000000"),
+	bannerFg:   lipgloss.Color("#FFFFFF"),
+	matchFg:    lipgloss.Color("#000000"),
+	logSources: []lipgloss.Color{
+		"#00AFFF", "#00FF00", "#FFFF00", "#FF00FF",
+		"#00FFFF", "#FF8700", "#87FF00", "#FF5FAF",
+	},
+}
+
+// ansi16Theme is the 16-color fallback of the spec: red, green, yellow,
+// blue, magenta, cyan, white and grey.
+var ansi16Theme = theme{
+	name:       ThemeANSI16,
+	primary:    lipgloss.Color("4"),
+	secondary:  lipgloss.Color("1"),
+	highlight:  lipgloss.Color("5"),
+	success:    lipgloss.Color("2"),
+	warning:    lipgloss.Color("3"),
+	error:      lipgloss.Color("9"),
+	muted:      lipgloss.Color("8"),
+	prodBg:     lipgloss.Color("1"),
+	warnBg:     lipgloss.Color("3"),
+	barBg:      lipgloss.Color("8"),
+	barFg:      lipgloss.Color("15"),
+	selectedBg: lipgloss.Color("8"),
+	bannerFg:   lipgloss.Color("15"),
+	matchFg:    lipgloss.Color("0"),
+	logSources: []lipgloss.Color{"4", "2", "3", "5", "6", "12", "10", "13"},
+	ansi:       true,
+}
+
+var themes = []theme{darkTheme, lightTheme, highContrastTheme, ansi16Theme}
+
+// ApplyTheme selects the theme named in config.yaml. Empty or auto picks
+// dark or light after the terminal background; any theme falls back to
+// ansi16 on a terminal without 256 colors.
+func ApplyTheme(name string) error {
+	darkBg := true
+	if name == "" || name == ThemeAuto {
+		darkBg = lipgloss.HasDarkBackground()
+	}
+	t, err := resolveTheme(name, lipgloss.ColorProfile(), darkBg)
+	if err != nil {
+		return err
+	}
+	applyTheme(t)
+	return nil
+}
+
+func resolveTheme(name string, profile termenv.Profile, darkBg bool) (theme, error) {
+	var t theme
+	switch name {
+	case "", ThemeAuto:
+		t = darkTheme
+		if !darkBg {
+			t = lightTheme
+		}
+	default:
+		found := false
+		for _, candidate := range themes {
+			if candidate.name == name {
+				t, found = candidate, true
+			}
+		}
+		if !found {
+			names := []string{ThemeAuto}
+			for _, candidate := range themes {
+				names = append(names, candidate.name)
+			}
+			return theme{}, fmt.Errorf("theme: thème inconnu %q (thèmes : %s)", name, strings.Join(names, ", "))
+		}
+	}
+	if (profile == termenv.ANSI || profile == termenv.Ascii) && !t.ansi {
+		t = ansi16Theme
+	}
+	return t, nil
+}
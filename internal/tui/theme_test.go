@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/muesli/termenv"
+)
+
+func TestResolveTheme(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile termenv.Profile
+		darkBg  bool
+		want    string
+	}{
+		{"", termenv.TrueColor, true, ThemeDark},
+		{ThemeAuto, termenv.ANSI256, false, ThemeLight},
+		{ThemeHighContrast, termenv.TrueColor, true, ThemeHighContrast},
+		{ThemeLight, termenv.ANSI, true, ThemeANSI16}, // 16 colors only
+		{ThemeAuto, termenv.Ascii, true, ThemeANSI16},
+		{ThemeANSI16, termenv.TrueColor, true, ThemeANSI16},
+	}
+	for _, tt := range tests {
+		got, err := resolveTheme(tt.name, tt.profile, tt.darkBg)
+		if err != nil || got.name != tt.want {
+			t.Errorf("resolveTheme(%q, %v, %v) = %q, %v; want %q", tt.name, tt.profile, tt.darkBg, got.name, err, tt.want)
+		}
+	}
+
+	if _, err := resolveTheme("solarized", termenv.TrueColor, true); err == nil || !strings.Contains(err.Error(), "high-contrast") {
+		t.Errorf("err = %v, want an unknown theme error listing the themes", err)
+	}
+}
+
+func TestApplyTheme_DerivesStyles(t *testing.T) {
+	t.Cleanup(func() { applyTheme(darkTheme) })
+
+	applyTheme(ansi16Theme)
+	if colorPrimary != "4" || titleStyle.GetForeground() != ansi16Theme.secondary {
+		t.Errorf("primary = %q, title = %v, want ansi16 colors", colorPrimary, titleStyle.GetForeground())
+	}
+	if eventWarningStyle.GetForeground() != ansi16Theme.warning || helpKeyStyle.GetForeground() != ansi16Theme.primary {
+		t.Error("styles of the views should follow the theme too")
+	}
+	for _, c := range logSourceColors {
+		if strings.HasPrefix(string(c), "#") {
+			t.Errorf("log source color %q is not one of the 16 base colors", c)
+		}
+	}
+
+	applyTheme(highContrastTheme)
+	if selectedStyle.GetForeground() != highContrastTheme.selectedFg {
+		t.Error("high contrast should set the foreground of the selected row")
+	}
+}
@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// maxCommandHistory caps the recent commands kept per namespace.
+const maxCommandHistory = 20
+
+type commandState struct {
+	podName       string
+	containerName string
+	command       string
+	content       string
+	lines         []string
+	stderrFrom    int // index of the first stderr line in lines
+	exitCode      int
+	offset        int
+}
+
+func (cs *commandState) setResult(res domain.CommandResult) {
+	stdout := strings.TrimRight(res.Stdout, "\n")
+	stderr := strings.TrimRight(res.Stderr, "\n")
+	cs.content = res.Stdout + res.Stderr
+	cs.lines = nil
+	if stdout != "" {
+		cs.lines = strings.Split(stdout, "\n")
+	}
+	cs.stderrFrom = len(cs.lines)
+	if stderr != "" {
+		cs.lines = append(cs.lines, strings.Split(stderr, "\n")...)
+	}
+	cs.exitCode = res.ExitCode
+	cs.offset = 0
+}
+
+func (cs *commandState) scrollDown(amount, viewHeight int) {
+	maxOffset := len(cs.lines) - viewHeight
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	cs.offset = min(cs.offset+amount, maxOffset)
+}
+
+func (cs *commandState) scrollUp(amount int) {
+	cs.offset = max(cs.offset-amount, 0)
+}
+
+func (cs *commandState) jumpToBottom(viewHeight int) {
+	maxOffset := len(cs.lines) - viewHeight
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	cs.offset = maxOffset
+}
+
+// commandArgv turns a command line into an argv. Plain commands are split on
+// whitespace so they work in images without a shell; lines using shell syntax
+// (pipes, redirections, variables...) are run through shell -c.
+func commandArgv(line, shell string) []string {
+	if strings.ContainsAny(line, "|&;<>$`*?'\"") {
+		return []string{shell, "-c", line}
+	}
+	return strings.Fields(line)
+}
+
+// pushCommandHistory adds line to the front of history, dropping duplicates
+// and keeping at most maxCommandHistory entries.
+func pushCommandHistory(history []string, line string) []string {
+	return pushHistory(history, line, maxCommandHistory)
+}
+
+func renderCommandPrompt(target, input string, history []string, historyIdx int) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("\n  Commande dans %s : %s\n", target, input))
+	if len(history) > 0 {
+		b.WriteString("\n  Récentes :\n")
+		for i, h := range history {
+			if i >= 5 {
+				break
+			}
+			if i == historyIdx {
+				b.WriteString(fmt.Sprintf("  > %s\n", selectedStyle.Render(h)))
+			} else {
+				b.WriteString(fmt.Sprintf("    %s\n", h))
+			}
+		}
+	}
+	b.WriteString("\n  enter:exécuter  ↑/↓:historique  esc:annuler\n")
+	return b.String()
+}
+
+func renderCommandOutput(cs *commandState, width, viewHeight int) string {
+	var b strings.Builder
+
+	target := cs.podName
+	if cs.containerName != "" {
+		target = cs.podName + "/" + cs.containerName
+	}
+	first := cs.offset + 1
+	last := min(cs.offset+viewHeight, len(cs.lines))
+	if len(cs.lines) == 0 {
+		first = 0
+	}
+	header := fmt.Sprintf("  %s $ %s (exit %d) [%d-%d/%d]", target, cs.command, cs.exitCode, first, last, len(cs.lines))
+	b.WriteString(headerStyle.Render(header))
+	b.WriteString("\n")
+
+	if len(cs.lines) == 0 {
+		b.WriteString("  Aucune sortie\n")
+		return b.String()
+	}
+
+	stderrStyle := lipgloss.NewStyle().Foreground(colorError)
+	usable := width - 2
+	if usable < 1 {
+		usable = 1
+	}
+	for i := cs.offset; i < last; i++ {
+		line := truncate(cs.lines[i], usable)
+		b.WriteString("  ")
+		if i >= cs.stderrFrom {
+			b.WriteString(stderrStyle.Render(line))
+		} else {
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func commandHelpKeys() string {
+	return hints(hint("scroll", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("page", keys.PageUp, keys.PageDown), hint("relancer", keys.Refresh),
+		hint("retour", keys.Escape))
+}
@@ -0,0 +1,255 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newCommandTestModel(pods []domain.PodInfo) (Model, *domain.MockGateway) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		Pods:         pods,
+		CommandResult: domain.CommandResult{
+			Stdout: "HOME=/root\nPATH=/usr/bin\n",
+		},
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+	return m, mock
+}
+
+func typeText(m Model, text string) Model {
+	for _, r := range text {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func TestRunCmdKey_SingleContainer_OpensPrompt(t *testing.T) {
+	m, _ := newCommandTestModel([]domain.PodInfo{{Name: "web-1", Status: "Running"}})
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	um := updated.(Model)
+
+	if !um.commandActive {
+		t.Fatal("commandActive should be true")
+	}
+	if um.commandPodName != "web-1" {
+		t.Errorf("commandPodName = %q, want web-1", um.commandPodName)
+	}
+	if !strings.Contains(um.View(), "Commande dans web-1") {
+		t.Error("view should render the command prompt")
+	}
+}
+
+func TestRunCmdKey_MultiContainer_UsesSelector(t *testing.T) {
+	m, _ := newCommandTestModel([]domain.PodInfo{{
+		Name:       "web-1",
+		Containers: []domain.ContainerInfo{{Name: "app"}, {Name: "sidecar"}},
+	}})
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	um := updated.(Model)
+	if !um.containerSelector || um.containerSelectorAction != "run" {
+		t.Fatalf("selector = %v action = %q, want run selector", um.containerSelector, um.containerSelectorAction)
+	}
+
+	updated, _ = um.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	updated, _ = updated.(Model).Update(tea.KeyMsg{Type: tea.KeyEnter})
+	um = updated.(Model)
+	if !um.commandActive {
+		t.Fatal("commandActive should be true after picking a container")
+	}
+	if um.commandContainer != "sidecar" {
+		t.Errorf("commandContainer = %q, want sidecar", um.commandContainer)
+	}
+}
+
+func TestRunCmdKey_ReadonlyNamespace_Blocked(t *testing.T) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "kube-system",
+		Pods:         []domain.PodInfo{{Name: "coredns-1"}},
+	}
+	cfg := config.DefaultConfig()
+	cfg.ReadonlyNamespaces = []string{"kube-*"}
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.pods = mock.Pods
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	um := updated.(Model)
+
+	if um.commandActive {
+		t.Error("command prompt should not open in a readonly namespace")
+	}
+	if !strings.Contains(um.toast.message, "lecture seule") {
+		t.Errorf("toast = %q, want readonly message", um.toast.message)
+	}
+}
+
+func TestCommandInput_EnterRunsCommand(t *testing.T) {
+	m, mock := newCommandTestModel([]domain.PodInfo{{Name: "web-1"}})
+	m, _ = m.activateCommandInput("web-1", "app")
+	m = typeText(m, "df -h")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	um := updated.(Model)
+
+	if um.view != ViewCommand {
+		t.Fatalf("view = %v, want ViewCommand", um.view)
+	}
+	if um.prevView != ViewPods {
+		t.Errorf("prevView = %v, want ViewPods", um.prevView)
+	}
+	if cmd == nil {
+		t.Fatal("expected cmd running the command")
+	}
+	msg := cmd()
+	if !reflect.DeepEqual(mock.RanCommand, []string{"df", "-h"}) {
+		t.Errorf("RanCommand = %v, want [df -h]", mock.RanCommand)
+	}
+	if mock.ExecContainer != "app" {
+		t.Errorf("ExecContainer = %q, want app", mock.ExecContainer)
+	}
+
+	updated, _ = um.Update(msg)
+	um = updated.(Model)
+	if len(um.commandState.lines) != 2 {
+		t.Errorf("lines = %v, want 2 lines", um.commandState.lines)
+	}
+	if !strings.Contains(um.View(), "PATH=/usr/bin") {
+		t.Error("view should render the command output")
+	}
+}
+
+func TestCommandInput_HistoryPerNamespace(t *testing.T) {
+	m, _ := newCommandTestModel([]domain.PodInfo{{Name: "web-1"}})
+	for _, line := range []string{"env", "df -h"} {
+		m, _ = m.activateCommandInput("web-1", "")
+		m = typeText(m, line)
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+	}
+
+	got := m.commandHistory["default"]
+	if !reflect.DeepEqual(got, []string{"df -h", "env"}) {
+		t.Fatalf("history = %v, want [df -h env]", got)
+	}
+
+	m, _ = m.activateCommandInput("web-1", "")
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	updated, _ = updated.(Model).Update(tea.KeyMsg{Type: tea.KeyUp})
+	um := updated.(Model)
+	if um.commandInput.Value() != "env" {
+		t.Errorf("input after 2x up = %q, want env", um.commandInput.Value())
+	}
+
+	mockOf(um).NamespaceVal = "other"
+	if len(um.commandHistory[um.client.GetNamespace()]) != 0 {
+		t.Error("history should be scoped per namespace")
+	}
+}
+
+func TestCommandInput_EscCancels(t *testing.T) {
+	m, mock := newCommandTestModel([]domain.PodInfo{{Name: "web-1"}})
+	m, _ = m.activateCommandInput("web-1", "")
+	m = typeText(m, "env")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	um := updated.(Model)
+
+	if um.commandActive {
+		t.Error("commandActive should be false after esc")
+	}
+	if mock.RunCommandCalls != 0 {
+		t.Error("esc should not run the command")
+	}
+}
+
+func TestCommandView_EscReturnsToPods(t *testing.T) {
+	m, _ := newCommandTestModel(nil)
+	m.prevView = ViewPods
+	m.view = ViewCommand
+	m.commandState = commandState{podName: "web-1", command: "env"}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	um := updated.(Model)
+	if um.view != ViewPods {
+		t.Errorf("view = %v, want ViewPods", um.view)
+	}
+}
+
+func TestCommandView_RefreshReruns(t *testing.T) {
+	m, mock := newCommandTestModel(nil)
+	m.prevView = ViewPods
+	m.view = ViewCommand
+	m.commandState = commandState{podName: "web-1", command: "env"}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if cmd == nil {
+		t.Fatal("expected cmd to rerun the command")
+	}
+	cmd()
+	if mock.RunCommandCalls != 1 {
+		t.Errorf("RunCommandCalls = %d, want 1", mock.RunCommandCalls)
+	}
+}
+
+func TestCommandState_SetResultSeparatesStderr(t *testing.T) {
+	var cs commandState
+	cs.setResult(domain.CommandResult{Stdout: "out1\nout2\n", Stderr: "err1\n", ExitCode: 1})
+
+	if len(cs.lines) != 3 {
+		t.Fatalf("lines = %v, want 3", cs.lines)
+	}
+	if cs.stderrFrom != 2 {
+		t.Errorf("stderrFrom = %d, want 2", cs.stderrFrom)
+	}
+	out := renderCommandOutput(&cs, 80, 10)
+	if !strings.Contains(out, "exit 1") {
+		t.Error("header should show exit code")
+	}
+}
+
+func TestCommandArgv(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"env", []string{"env"}},
+		{"cat  /etc/config.yaml", []string{"cat", "/etc/config.yaml"}},
+		{"env | grep PATH", []string{"/bin/sh", "-c", "env | grep PATH"}},
+		{"echo $HOME", []string{"/bin/sh", "-c", "echo $HOME"}},
+	}
+	for _, tt := range tests {
+		got := commandArgv(tt.line, "/bin/sh")
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("commandArgv(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestPushCommandHistory(t *testing.T) {
+	h := pushCommandHistory([]string{"a", "b", "c"}, "b")
+	if !reflect.DeepEqual(h, []string{"b", "a", "c"}) {
+		t.Errorf("history = %v, want [b a c]", h)
+	}
+
+	var long []string
+	for i := 0; i < maxCommandHistory+5; i++ {
+		long = pushCommandHistory(long, strings.Repeat("x", i+1))
+	}
+	if len(long) != maxCommandHistory {
+		t.Errorf("len = %d, want %d", len(long), maxCommandHistory)
+	}
+}
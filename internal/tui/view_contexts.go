@@ -0,0 +1,187 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+type contextsLoadedMsg struct{ items []domain.ContextInfo }
+
+// contextSwitchedMsg reports the end of a context switch: client is the
+// gateway on the new context, or err is set and the previous one stays.
+type contextSwitchedMsg struct {
+	name   string
+	client domain.KubeGateway
+	err    error
+}
+
+// ctxMsg tags the result of a cluster call with the context generation it
+// was made in, so that results from the previous cluster are dropped once
+// the context switched.
+type ctxMsg struct {
+	gen int
+	msg tea.Msg
+}
+
+// inContext tags the message of cmd with the current context generation.
+func (m Model) inContext(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	gen := m.ctxGen
+	return func() tea.Msg {
+		msg := cmd()
+		if msg == nil {
+			return nil
+		}
+		return ctxMsg{gen: gen, msg: msg}
+	}
+}
+
+// handleCtxMsg drops a result from a previous context and handles the
+// others as if untagged.
+func (m Model) handleCtxMsg(msg ctxMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.ctxGen {
+		return m, nil
+	}
+	if batch, ok := msg.msg.(tea.BatchMsg); ok {
+		cmds := make([]tea.Cmd, len(batch))
+		for i, cmd := range batch {
+			cmds[i] = m.inContext(cmd)
+		}
+		return m, tea.Batch(cmds...)
+	}
+	return m.Update(msg.msg)
+}
+
+var contextColumns = []column{
+	{title: "NAME", width: 24, maxWidth: 56, flex: true},
+	{title: "CLUSTER", width: 24, from: widthMain, priority: 3, flex: true},
+	{title: "USER", width: 20, from: widthStandard, priority: 4},
+	{title: "NAMESPACE", width: 16, priority: 2},
+}
+
+func renderContextList(contexts []domain.ContextInfo, cursor, width, maxVisible int) string {
+	if len(contexts) == 0 {
+		return "  Aucun contexte dans le kubeconfig\n"
+	}
+
+	var b strings.Builder
+
+	widths := layoutColumns(contextColumns, width, 2)
+	b.WriteString(renderColumnHeader(contextColumns, widths, "  "))
+	b.WriteString("\n")
+
+	start := 0
+	if cursor >= maxVisible {
+		start = cursor - maxVisible + 1
+	}
+
+	for i := start; i < len(contexts) && i < start+maxVisible; i++ {
+		c := contexts[i]
+		marker := "  "
+		if c.Current {
+			marker = "> "
+		}
+		ns := c.Namespace
+		if ns == "" {
+			ns = "default"
+		}
+		line := renderColumnRow(contextColumns, widths, marker, []string{c.Name, c.Cluster, c.User, ns})
+
+		if i == cursor {
+			b.WriteString(selectedStyle.Width(width).Render(line))
+		} else {
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func contextHelpKeys() string {
+	return hints(hint("nav", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("basculer", keys.Enter), hint("filtre", keys.Filter),
+		hint("refresh", keys.Refresh), hint("quit", keys.Quit))
+}
+
+func (m Model) filteredContexts() []domain.ContextInfo {
+	f := m.filterText()
+	if f == "" {
+		return m.contexts
+	}
+	var result []domain.ContextInfo
+	for _, c := range m.contexts {
+		if strings.Contains(strings.ToLower(c.Name), f) ||
+			strings.Contains(strings.ToLower(c.Cluster), f) ||
+			strings.Contains(strings.ToLower(c.User), f) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// switchContext moves the gateway to the selected context. Watches are
+// stopped first since the client they read from is rebuilt.
+func (m Model) switchContext() (tea.Model, tea.Cmd) {
+	items := m.filteredContexts()
+	if m.cursor >= len(items) {
+		return m, nil
+	}
+	return m.switchContextTo(items[m.cursor].Name)
+}
+
+// switchContextTo moves to the named context. The gateway on it is built in
+// the background and swapped in by contextSwitched.
+func (m Model) switchContextTo(name string) (tea.Model, tea.Cmd) {
+	if name == m.client.GetContext() {
+		m.toast = newToast(fmt.Sprintf("Déjà sur le contexte %s", name), toastInfo)
+		return m, scheduleToastClear()
+	}
+
+	// The watches keep running until the switch succeeds, a failure leaves
+	// the current view as it was
+	m.loading = true
+	client := m.client
+	return m, func() tea.Msg {
+		gw, err := client.SwitchContext(name)
+		return contextSwitchedMsg{name: name, client: gw, err: err}
+	}
+}
+
+// contextSwitched resets everything loaded from the previous cluster and
+// opens the pods of the new context's namespace.
+func (m Model) contextSwitched(msg contextSwitchedMsg) (tea.Model, tea.Cmd) {
+	m.loading = false
+	if msg.err != nil {
+		m.toast = newToast(fmt.Sprintf("Contexte %s : %v", msg.name, msg.err), toastError)
+		return m, scheduleToastClear()
+	}
+
+	m.stopWatch()
+	m.stopTimeline()
+	m.alerts.stop()
+	m.stopLogFollow()
+	m.client = msg.client
+	m.ctxGen++
+	m.namespaces = nil
+	m.pods = nil
+	m.deployments = nil
+	m.events = nil
+	m.contexts = nil
+	m.overview = nil
+	m.eventScope = nil
+	m.marks = nil
+	m.alerts = alertState{}
+	m.disconnected = false
+	m.view = ViewPods
+	m.cursor = 0
+	m.filter.SetValue("")
+	m.loading = true
+	m.toast = newToast(fmt.Sprintf("Contexte : %s (ns %s)", msg.name, m.client.GetNamespace()), toastSuccess)
+	return m, tea.Batch(m.loadCurrentView(), m.startAlerts(), scheduleToastClear())
+}
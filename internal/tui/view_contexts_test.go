@@ -0,0 +1,157 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newContextsTestModel() (Model, *domain.MockGateway) {
+	mock := &domain.MockGateway{
+		ContextVal:   "dev",
+		NamespaceVal: "team-a",
+		Contexts: []domain.ContextInfo{
+			{Name: "dev", Cluster: "dev-cluster", User: "alice", Namespace: "team-a", Current: true},
+			{Name: "prod", Cluster: "prod-cluster", User: "alice-prod"},
+		},
+		WatchPodsCh: make(chan domain.WatchEvent),
+	}
+	m := NewModel(mock, nil, nil)
+	m.width = 120
+	m.height = 30
+	return m, mock
+}
+
+func TestTab5_ListsContexts(t *testing.T) {
+	m, _ := newContextsTestModel()
+	m.view = ViewPods
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}})
+	m = updated.(Model)
+	if m.view != ViewContexts {
+		t.Fatalf("view = %v, want ViewContexts", m.view)
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	view := m.View()
+	for _, want := range []string{"prod-cluster", "alice-prod", "> dev", "[5] Contexts"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("contexts view missing %q", want)
+		}
+	}
+}
+
+func TestSwitchContext_ResetsStateAndOpensPods(t *testing.T) {
+	m, mock := newContextsTestModel()
+	m.view = ViewContexts
+	m.contexts = mock.Contexts
+	m.pods = []domain.PodInfo{{Name: "old-pod"}}
+	m.eventScope = &domain.ObjectRef{Kind: "Pod", Name: "old-pod"}
+	m.alerts.unseen = 3
+	m.cursor = 1
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cmd == nil || !m.loading {
+		t.Fatal("enter should switch the context")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if mock.SwitchedContext != "prod" {
+		t.Errorf("SwitchedContext = %q, want prod", mock.SwitchedContext)
+	}
+	if m.view != ViewPods || m.pods != nil || m.eventScope != nil || m.alerts.unseen != 0 {
+		t.Errorf("state not reset: view = %v, pods = %v, scope = %v, unseen = %d", m.view, m.pods, m.eventScope, m.alerts.unseen)
+	}
+	if !strings.Contains(m.toast.message, "prod") {
+		t.Errorf("toast = %q, want the new context", m.toast.message)
+	}
+}
+
+func TestSwitchContext_DropsStaleResults(t *testing.T) {
+	m, _ := newContextsTestModel()
+	m.view = ViewPods
+	m.client.(*domain.MockGateway).Pods = []domain.PodInfo{{Name: "old-pod"}}
+	stale := m.loadCurrentView()
+
+	prod := &domain.MockGateway{NamespaceVal: "default", ContextVal: "prod", Pods: []domain.PodInfo{{Name: "new-pod"}}}
+	updated, _ := m.Update(contextSwitchedMsg{name: "prod", client: prod})
+	m = updated.(Model)
+	if m.client != domain.KubeGateway(prod) {
+		t.Fatal("the gateway on the new context should be swapped in")
+	}
+
+	// The list of the previous cluster lands after the switch
+	updated, _ = m.Update(stale())
+	m = updated.(Model)
+	if m.pods != nil {
+		t.Errorf("pods = %v, a result of the previous context should be dropped", m.pods)
+	}
+	updated, _ = m.Update(m.loadCurrentView()())
+	m = updated.(Model)
+	if len(m.pods) != 1 || m.pods[0].Name != "new-pod" {
+		t.Errorf("pods = %v, want those of the new context", m.pods)
+	}
+}
+
+func TestSwitchContext_CurrentIsNoop(t *testing.T) {
+	m, mock := newContextsTestModel()
+	m.view = ViewContexts
+	m.contexts = mock.Contexts
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.loading || mock.SwitchedContext != "" {
+		t.Error("selecting the current context should not switch")
+	}
+}
+
+func TestSwitchContext_ErrorStaysOnContexts(t *testing.T) {
+	m, mock := newContextsTestModel()
+	mock.SwitchContextErr = errors.New("token manquant")
+	m.view = ViewContexts
+	m.contexts = mock.Contexts
+	m.cursor = 1
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if m.view != ViewContexts || m.loading {
+		t.Errorf("view = %v, loading = %v, want ViewContexts idle", m.view, m.loading)
+	}
+	if !strings.Contains(m.toast.message, "token manquant") {
+		t.Errorf("toast = %q, want the error", m.toast.message)
+	}
+}
+
+func TestSwitchContext_ErrorKeepsWatches(t *testing.T) {
+	m, mock := newContextsTestModel()
+	mock.SwitchContextErr = errors.New("token manquant")
+	m.view = ViewPods
+	cancelled := false
+	m.watching = true
+	m.watchCancel = func() { cancelled = true }
+	m.logState.following = true
+	m.logCancel = func() { cancelled = true }
+
+	updated, cmd := m.switchContextTo("prod")
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if cancelled || !m.watching || !m.logState.following {
+		t.Errorf("cancelled = %v, watching = %v, following = %v, want the watches kept on a failed switch",
+			cancelled, m.watching, m.logState.following)
+	}
+	if m.client != mock {
+		t.Error("the client should stay on the current context")
+	}
+}
@@ -0,0 +1,187 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type copyDirection int
+
+const (
+	copyDownload copyDirection = iota // container -> local
+	copyUpload                        // local -> container
+)
+
+// copyState drives the two-step path prompt (source, then destination) and
+// the progress line of a running file copy.
+type copyState struct {
+	direction     copyDirection
+	podName       string
+	containerName string
+	step          int // 0: source path, 1: destination path
+	source        string
+	input         textinput.Model
+
+	// Running copy
+	running  bool
+	dest     string
+	bytes    int64
+	total    int64 // known for uploads only
+	progress chan int64
+}
+
+type copyProgressMsg struct{ bytes int64 }
+
+// copyConfirmedMsg starts an upload once the prod confirmation is accepted.
+type copyConfirmedMsg struct{}
+
+type copyDoneMsg struct {
+	direction copyDirection
+	dest      string
+	bytes     int64
+	err       error
+}
+
+func newCopyState() copyState {
+	ti := textinput.New()
+	ti.CharLimit = 512
+	ti.Width = 60
+	return copyState{input: ti}
+}
+
+func (cs *copyState) activate(direction copyDirection, podName, containerName string) tea.Cmd {
+	cs.direction = direction
+	cs.podName = podName
+	cs.containerName = containerName
+	cs.step = 0
+	cs.source = ""
+	if direction == copyDownload {
+		cs.input.Placeholder = "/tmp/heap.hprof"
+	} else {
+		cs.input.Placeholder = "./config.yaml"
+	}
+	cs.input.SetValue("")
+	cs.input.Focus()
+	return textinput.Blink
+}
+
+func (cs *copyState) isActive() bool {
+	return cs.input.Focused()
+}
+
+func (cs *copyState) cancel() {
+	cs.input.Blur()
+	cs.input.SetValue("")
+	cs.step = 0
+}
+
+// next validates the current step. It returns true once both paths are known,
+// after prefilling the destination with a default derived from the source.
+func (cs *copyState) next() bool {
+	value := strings.TrimSpace(cs.input.Value())
+	if value == "" {
+		return false
+	}
+	if cs.step == 0 {
+		cs.source = value
+		cs.step = 1
+		cs.input.SetValue(defaultCopyDest(cs.direction, value))
+		cs.input.CursorEnd()
+		return false
+	}
+	cs.dest = value
+	cs.input.Blur()
+	return true
+}
+
+// defaultCopyDest suggests a destination keeping the source file name:
+// the current directory for downloads, /tmp/ in the container for uploads.
+func defaultCopyDest(direction copyDirection, source string) string {
+	if direction == copyDownload {
+		return "./" + path.Base(path.Clean(source))
+	}
+	return "/tmp/" + filepath.Base(filepath.Clean(source))
+}
+
+// localSize returns the total size of the regular files under p.
+func localSize(p string) int64 {
+	var total int64
+	_ = filepath.Walk(p, func(_ string, fi os.FileInfo, err error) error {
+		if err == nil && fi.Mode().IsRegular() {
+			total += fi.Size()
+		}
+		return nil
+	})
+	return total
+}
+
+// listenCopyProgress waits for the next progress update; it yields nothing
+// once the copy has finished and closed the channel.
+func listenCopyProgress(ch <-chan int64) tea.Cmd {
+	return func() tea.Msg {
+		n, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return copyProgressMsg{bytes: n}
+	}
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d o", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %co", float64(n)/float64(div), "KMGT"[exp])
+}
+
+func (cs *copyState) target() string {
+	if cs.containerName != "" {
+		return cs.podName + "/" + cs.containerName
+	}
+	return cs.podName
+}
+
+func renderCopyPrompt(cs *copyState) string {
+	var label string
+	switch {
+	case cs.direction == copyDownload && cs.step == 0:
+		label = fmt.Sprintf("Télécharger depuis %s — chemin dans le conteneur", cs.target())
+	case cs.direction == copyDownload:
+		label = fmt.Sprintf("Télécharger %s:%s — destination locale", cs.target(), cs.source)
+	case cs.step == 0:
+		label = fmt.Sprintf("Envoyer vers %s — fichier local", cs.target())
+	default:
+		label = fmt.Sprintf("Envoyer %s vers %s — destination dans le conteneur", cs.source, cs.target())
+	}
+	return fmt.Sprintf("\n  %s : %s\n\n  enter:valider  esc:annuler\n", label, cs.input.View())
+}
+
+// renderCopyProgress renders the progress line of a running copy, with a bar
+// when the total size is known.
+func renderCopyProgress(cs *copyState, width int) string {
+	arrow := "↓"
+	if cs.direction == copyUpload {
+		arrow = "↑"
+	}
+	line := fmt.Sprintf("  %s %s → %s  %s", arrow, cs.source, cs.dest, formatBytes(cs.bytes))
+	if cs.total > 0 {
+		pct := min(int(cs.bytes*100/cs.total), 100)
+		barWidth := 20
+		filled := pct * barWidth / 100
+		line += fmt.Sprintf(" / %s  [%s%s] %d%%", formatBytes(cs.total),
+			strings.Repeat("█", filled), strings.Repeat("░", barWidth-filled), pct)
+	}
+	return truncate(line, width) + "\n"
+}
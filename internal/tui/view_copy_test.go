@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/config"
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newCopyTestModel(ns string, pods []domain.PodInfo, cfg *config.AppConfig) (Model, *domain.MockGateway) {
+	mock := &domain.MockGateway{
+		NamespaceVal: ns,
+		Pods:         pods,
+		CopyBytes:    2048,
+	}
+	m := NewModel(mock, nil, cfg)
+	m.view = ViewPods
+	m.pods = mock.Pods
+	m.width = 120
+	m.height = 30
+	return m, mock
+}
+
+func pressKey(m Model, k string) (Model, tea.Cmd) {
+	var msg tea.KeyMsg
+	switch k {
+	case "enter":
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	default:
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+	}
+	updated, cmd := m.Update(msg)
+	return updated.(Model), cmd
+}
+
+// runCopyCmd executes the batch returned by startCopy and feeds the done
+// message back into the model.
+func runCopyCmd(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a copy cmd")
+	}
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok {
+		t.Fatal("expected a batch of copy and progress cmds")
+	}
+	for _, c := range batch {
+		if msg, ok := c().(copyDoneMsg); ok {
+			updated, _ := m.Update(msg)
+			return updated.(Model)
+		}
+	}
+	t.Fatal("no copyDoneMsg in batch")
+	return m
+}
+
+func TestDownloadKey_PromptsSourceThenDestination(t *testing.T) {
+	m, mock := newCopyTestModel("default", []domain.PodInfo{{Name: "web-1", Status: "Running"}}, nil)
+
+	m, _ = pressKey(m, "D")
+	if !m.fileCopy.isActive() {
+		t.Fatal("copy prompt should be active")
+	}
+	if !strings.Contains(m.View(), "chemin dans le conteneur") {
+		t.Error("view should ask for the container path")
+	}
+
+	m = typeText(m, "/tmp/heap.hprof")
+	m, cmd := pressKey(m, "enter")
+	if cmd != nil || !m.fileCopy.isActive() {
+		t.Fatal("first enter should move to the destination step")
+	}
+	if got := m.fileCopy.input.Value(); got != "./heap.hprof" {
+		t.Errorf("default destination = %q, want ./heap.hprof", got)
+	}
+
+	m, cmd = pressKey(m, "enter")
+	if !m.fileCopy.running {
+		t.Error("copy should be running")
+	}
+	if !strings.Contains(m.View(), "/tmp/heap.hprof → ./heap.hprof") {
+		t.Error("view should show the copy progress line")
+	}
+	m = runCopyCmd(t, m, cmd)
+
+	if mock.CopyDirection != "from" || mock.CopyPod != "web-1" || mock.CopySrc != "/tmp/heap.hprof" || mock.CopyDst != "./heap.hprof" {
+		t.Errorf("copy = %s %s %s -> %s", mock.CopyDirection, mock.CopyPod, mock.CopySrc, mock.CopyDst)
+	}
+	if m.fileCopy.running {
+		t.Error("copy should be finished")
+	}
+	if !strings.Contains(m.toast.message, "./heap.hprof") || !strings.Contains(m.toast.message, "2.0 Ko") {
+		t.Errorf("toast = %q, want destination and size", m.toast.message)
+	}
+}
+
+func TestUploadKey_MultiContainer_UsesSelector(t *testing.T) {
+	m, mock := newCopyTestModel("default", []domain.PodInfo{{
+		Name:       "web-1",
+		Containers: []domain.ContainerInfo{{Name: "app"}, {Name: "sidecar"}},
+	}}, nil)
+
+	m, _ = pressKey(m, "U")
+	if !m.containerSelector || m.containerSelectorAction != "upload" {
+		t.Fatalf("selector = %v action = %q, want upload selector", m.containerSelector, m.containerSelectorAction)
+	}
+	m, _ = pressKey(m, "enter")
+	if !m.fileCopy.isActive() || m.fileCopy.containerName != "app" {
+		t.Fatalf("prompt active = %v container = %q", m.fileCopy.isActive(), m.fileCopy.containerName)
+	}
+
+	src := filepath.Join(t.TempDir(), "fix.yaml")
+	_ = os.WriteFile(src, []byte("a: 1"), 0o644)
+	m = typeText(m, src)
+	m, _ = pressKey(m, "enter")
+	if got := m.fileCopy.input.Value(); got != "/tmp/fix.yaml" {
+		t.Errorf("default destination = %q, want /tmp/fix.yaml", got)
+	}
+	m, cmd := pressKey(m, "enter")
+	if m.fileCopy.total != 4 {
+		t.Errorf("total = %d, want local size 4", m.fileCopy.total)
+	}
+	runCopyCmd(t, m, cmd)
+
+	if mock.CopyDirection != "to" || mock.CopyContainer != "app" || mock.CopyDst != "/tmp/fix.yaml" {
+		t.Errorf("copy = %s %s -> %s", mock.CopyDirection, mock.CopyContainer, mock.CopyDst)
+	}
+}
+
+func TestCopy_Escape_Cancels(t *testing.T) {
+	m, mock := newCopyTestModel("default", []domain.PodInfo{{Name: "web-1"}}, nil)
+
+	m, _ = pressKey(m, "D")
+	m = typeText(m, "/tmp/x")
+	m, _ = pressKey(m, "esc")
+
+	if m.fileCopy.isActive() {
+		t.Error("prompt should be closed")
+	}
+	if mock.CopyPod != "" {
+		t.Error("no copy should run")
+	}
+}
+
+func TestCopy_ReadonlyNamespace_Blocked(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ReadonlyNamespaces = []string{"kube-*"}
+	m, _ := newCopyTestModel("kube-system", []domain.PodInfo{{Name: "coredns-1"}}, cfg)
+
+	m, _ = pressKey(m, "D")
+
+	if m.fileCopy.isActive() {
+		t.Error("prompt should not open in a readonly namespace")
+	}
+	if !strings.Contains(m.toast.message, "lecture seule") {
+		t.Errorf("toast = %q, want readonly message", m.toast.message)
+	}
+}
+
+func TestUpload_ProdNamespace_RequiresConfirmation(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ProdPatterns = []string{"prod"}
+	m, mock := newCopyTestModel("api-prod", []domain.PodInfo{{Name: "web-1"}}, cfg)
+
+	m, _ = pressKey(m, "U")
+	m = typeText(m, "./fix.yaml")
+	m, _ = pressKey(m, "enter")
+	m, cmd := pressKey(m, "enter")
+
+	if cmd != nil || m.fileCopy.running {
+		t.Error("upload must wait for confirmation")
+	}
+	if !m.confirm.isActive() || m.confirm.mode != confirmProd {
+		t.Fatal("expected prod confirmation")
+	}
+
+	updated, cmd := m.Update(copyConfirmedMsg{})
+	m = updated.(Model)
+	runCopyCmd(t, m, cmd)
+	if mock.CopyDirection != "to" {
+		t.Error("upload should run after confirmation")
+	}
+}
+
+func TestCopy_TarMissing_ShowsError(t *testing.T) {
+	m, mock := newCopyTestModel("default", []domain.PodInfo{{Name: "web-1"}}, nil)
+	mock.CopyErr = domain.ErrNoTar
+
+	m, _ = pressKey(m, "D")
+	m = typeText(m, "/tmp/x")
+	m, _ = pressKey(m, "enter")
+	m, cmd := pressKey(m, "enter")
+	m = runCopyCmd(t, m, cmd)
+
+	if m.toast.level != toastError || !strings.Contains(m.toast.message, "tar") {
+		t.Errorf("toast = %q, want tar error", m.toast.message)
+	}
+}
+
+func TestCopyProgressMsg_UpdatesBytes(t *testing.T) {
+	m, _ := newCopyTestModel("default", []domain.PodInfo{{Name: "web-1"}}, nil)
+	m.fileCopy.running = true
+	m.fileCopy.progress = make(chan int64, 1)
+
+	updated, cmd := m.Update(copyProgressMsg{bytes: 512})
+	um := updated.(Model)
+
+	if um.fileCopy.bytes != 512 {
+		t.Errorf("bytes = %d, want 512", um.fileCopy.bytes)
+	}
+	if cmd == nil {
+		t.Error("should keep listening for progress")
+	}
+
+	um.fileCopy.progress = nil
+	if _, cmd := um.Update(copyProgressMsg{bytes: 1024}); cmd != nil {
+		t.Error("stale progress after completion should not re-listen")
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 o"},
+		{1023, "1023 o"},
+		{2048, "2.0 Ko"},
+		{5 * 1024 * 1024, "5.0 Mo"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestRenderCopyProgress_WithTotal(t *testing.T) {
+	cs := copyState{direction: copyUpload, source: "a", dest: "/tmp/a", bytes: 50, total: 100}
+	got := renderCopyProgress(&cs, 200)
+	if !strings.Contains(got, "50%") || !strings.Contains(got, "↑") {
+		t.Errorf("progress = %q, want upload arrow and 50%%", got)
+	}
+}
@@ -2,30 +2,37 @@ package tui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/Taishi66/okd-tui/internal/config"
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
-func renderDeploymentList(deps []domain.DeploymentInfo, cursor, width, maxVisible int) string {
+func deploymentColumns(allNS bool, extra []config.ColumnConfig) []column {
+	return append([]column{
+		namespaceColumn(allNS),
+		{title: "NAME", width: 24, maxWidth: 56, flex: true},
+		{title: "READY", width: 10, priority: 1, render: colorizeReady},
+		{title: "AVAIL", width: 8, from: widthMain, priority: 3},
+		{title: "AGE", width: 6, from: widthMain, priority: 4},
+		{title: "IMAGE", width: 24, from: widthStandard, priority: 6, flex: true},
+	}, extraColumns(extra)...)
+}
+
+func renderDeploymentList(deps []domain.DeploymentInfo, cursor, width, maxVisible int, showNS bool, extra []config.ColumnConfig,
+	marks map[string]string) string {
 	if len(deps) == 0 {
-		return "  Aucun deployment dans ce namespace\n"
+		return "  Aucun deployment " + listScope(showNS) + "\n"
 	}
 
 	var b strings.Builder
 
-	if width >= 120 {
-		header := fmt.Sprintf("  %-38s %-10s %-10s %-8s %s", "NAME", "READY", "AVAIL", "AGE", "IMAGE")
-		b.WriteString(headerStyle.Render(header))
-	} else if width >= 80 {
-		header := fmt.Sprintf("  %-35s %-10s %-10s %s", "NAME", "READY", "AVAIL", "AGE")
-		b.WriteString(headerStyle.Render(header))
-	} else {
-		header := fmt.Sprintf("  %-30s %-10s %s", "NAME", "READY", "AGE")
-		b.WriteString(headerStyle.Render(header))
-	}
+	cols := deploymentColumns(showNS, extra)
+	widths := layoutColumns(cols, width, 2)
+	b.WriteString(renderColumnHeader(cols, widths, "  "))
 	b.WriteString("\n")
 
 	start := 0
@@ -35,20 +42,9 @@ func renderDeploymentList(deps []domain.DeploymentInfo, cursor, width, maxVisibl
 
 	for i := start; i < len(deps) && i < start+maxVisible; i++ {
 		d := deps[i]
-		readyColor := colorizeReady(d.Ready)
-
-		var line string
-		if width >= 120 {
-			line = fmt.Sprintf("  %-38s %-10s %-10d %-8s %s",
-				truncate(d.Name, 37), readyColor, d.Available, d.Age,
-				truncate(d.Image, width-75))
-		} else if width >= 80 {
-			line = fmt.Sprintf("  %-35s %-10s %-10d %s",
-				truncate(d.Name, 34), readyColor, d.Available, d.Age)
-		} else {
-			line = fmt.Sprintf("  %-30s %-10s %s",
-				truncate(d.Name, 29), readyColor, d.Age)
-		}
+		line := renderColumnRow(cols, widths, rowPrefix(marks, d.Namespace, d.Name), append([]string{
+			d.Namespace, d.Name, d.Ready, strconv.Itoa(int(d.Available)), d.Age, d.Image,
+		}, extraCells(extra, d.Extra)...))
 
 		if i == cursor {
 			b.WriteString(selectedStyle.Width(width).Render(line))
@@ -74,5 +70,9 @@ func colorizeReady(ready string) string {
 }
 
 func deploymentHelpKeys() string {
-	return "j/k:nav  g/G:début/fin  +/-:scale  s:scale set  y:yaml  t:tri  /:filtre  r:refresh  q:quit"
+	return hints(hint("nav", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("logs", keys.Enter),
+		hint("scale", keys.ScaleUp, keys.ScaleDn), hint("scale set", keys.ScaleSet), hint("restart", keys.Restart),
+		hint("marquer", keys.Mark, keys.MarkAll), hint("yaml", keys.YAML),
+		hint("events", keys.ObjEvts), hint("tous ns", keys.AllNS), hint("tri", keys.Sort), hint("filtre", keys.Filter),
+		hint("refresh", keys.Refresh), hint("quit", keys.Quit))
 }
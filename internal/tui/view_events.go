@@ -1,27 +1,35 @@
 package tui
 
 import (
-	"fmt"
+	"strconv"
 	"strings"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/Taishi66/okd-tui/internal/config"
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
-var (
-	eventWarningStyle = lipgloss.NewStyle().Foreground(colorWarning)
-	eventNormalStyle  = lipgloss.NewStyle().Foreground(colorSuccess)
-)
+func eventColumns(allNS bool, extra []config.ColumnConfig) []column {
+	return append([]column{
+		namespaceColumn(allNS),
+		{title: "TYPE", width: 8, priority: 1, render: colorizeEventType},
+		{title: "REASON", width: 20},
+		{title: "OBJECT", width: 24, from: widthMain, priority: 3},
+		{title: "MESSAGE", width: 30, from: widthMain, priority: 5, flex: true},
+		{title: "AGE", width: 6, from: widthMain, priority: 4},
+		{title: "COUNT", width: 5, from: widthStandard, priority: 6},
+	}, extraColumns(extra)...)
+}
 
-func renderEventList(events []domain.EventInfo, cursor, width, maxVisible int) string {
+func renderEventList(events []domain.EventInfo, cursor, width, maxVisible int, showNS bool, extra []config.ColumnConfig) string {
 	if len(events) == 0 {
-		return "  Aucun event dans ce namespace\n"
+		return "  Aucun event " + listScope(showNS) + "\n"
 	}
 
 	var b strings.Builder
 
-	header := fmt.Sprintf("  %-10s %-22s %-28s %-40s %-8s %s", "TYPE", "REASON", "OBJECT", "MESSAGE", "AGE", "COUNT")
-	b.WriteString(headerStyle.Render(header))
+	cols := eventColumns(showNS, extra)
+	widths := layoutColumns(cols, width, 2)
+	b.WriteString(renderColumnHeader(cols, widths, "  "))
 	b.WriteString("\n")
 
 	start := 0
@@ -31,21 +39,9 @@ func renderEventList(events []domain.EventInfo, cursor, width, maxVisible int) s
 
 	for i := start; i < len(events) && i < start+maxVisible; i++ {
 		e := events[i]
-
-		typeStr := e.Type
-		if e.Type == "Warning" {
-			typeStr = eventWarningStyle.Render(e.Type)
-		} else {
-			typeStr = eventNormalStyle.Render(e.Type)
-		}
-
-		line := fmt.Sprintf("  %-10s %-22s %-28s %-40s %-8s %d",
-			typeStr,
-			truncate(e.Reason, 21),
-			truncate(e.Object, 27),
-			truncate(e.Message, 39),
-			e.Age,
-			e.Count)
+		line := renderColumnRow(cols, widths, "  ", append([]string{
+			e.Namespace, e.Type, e.Reason, e.Object, e.Message, e.Age, strconv.Itoa(int(e.Count)),
+		}, extraCells(extra, e.Extra)...))
 
 		if i == cursor {
 			b.WriteString(selectedStyle.Width(width).Render(line))
@@ -58,6 +54,23 @@ func renderEventList(events []domain.EventInfo, cursor, width, maxVisible int) s
 	return b.String()
 }
 
-func eventHelpKeys() string {
-	return "j/k:nav  g/G:début/fin  t:tri  /:filtre  r:refresh  q:quit"
+func colorizeEventType(t string) string {
+	if t == "Warning" {
+		return eventWarningStyle.Render(t)
+	}
+	return eventNormalStyle.Render(t)
+}
+
+func eventHelpKeys(timeline, scoped bool) string {
+	parts := []string{hint("nav", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom)}
+	if timeline {
+		parts = append(parts, hint("tableau", keys.Timeline))
+	} else {
+		parts = append(parts, hint("tri", keys.Sort), hint("timeline", keys.Timeline), hint("tous ns", keys.AllNS))
+	}
+	parts = append(parts, hint("filtre", keys.Filter), hint("refresh", keys.Refresh))
+	if scoped {
+		parts = append(parts, hint("retour", keys.Escape))
+	}
+	return hints(append(parts, hint("quit", keys.Quit))...)
 }
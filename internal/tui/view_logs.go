@@ -3,58 +3,254 @@ package tui
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
 // Compiled regexes for log line colorization.
 var (
 	reTimestamp  = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[ T]\d{2}:\d{2}:\d{2}[\.\d]*`)
-	reLogLevel   = regexp.MustCompile(`\b(INFO|WARN|WARNING|ERROR|FATAL|SEVERE|DEBUG|TRACE)\b`)
+	reLogLevel   = regexp.MustCompile(`\b(INFO|WARN|WARNING|ERROR|FATAL|SEVERE|DEBUG|TRACE)\b|\blevel"?\s*[=:]\s*"?((?i:info|warn|warning|error|fatal|severe|debug|trace))\b`)
 	reHTTPMethod = regexp.MustCompile(`\b(GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS)\b`)
 	reHTTPStatus = regexp.MustCompile(`\b([2-5]\d{2})\b`)
 )
 
+// logLevel returns the level of line in upper case, "" when it has none.
+// Upper-case words count anywhere; structured loggers' "level":"error" or
+// level=warn count whatever their case.
+func logLevel(line string) string {
+	m := reLogLevel.FindStringSubmatch(line)
+	if m == nil {
+		return ""
+	}
+	return strings.ToUpper(m[1] + m[2])
+}
+
+// replaceLogLevels replaces each level word of line, as found by logLevel,
+// with repl of it.
+func replaceLogLevels(line string, repl func(string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range reLogLevel.FindAllStringSubmatchIndex(line, -1) {
+		start, end := loc[2], loc[3]
+		if start < 0 {
+			start, end = loc[4], loc[5]
+		}
+		b.WriteString(line[last:start])
+		b.WriteString(repl(line[start:end]))
+		last = end
+	}
+	b.WriteString(line[last:])
+	return b.String()
+}
+
+// maxFollowLines caps the lines kept while following a log; the oldest
+// lines are dropped first.
+const maxFollowLines = 10000
+
 type logState struct {
 	podName       string
 	containerName string
+	selector      string // aggregated view: label selector of the followed pods
+	title         string // aggregated view: header label, e.g. "deploy/web"
 	content       string
 	lines         []string
 	offset        int
 	previous      bool
 	wrap          bool
+	following     bool // lines are streamed live
+	paused        bool // user scrolled up: stop sticking to the bottom
+	rng           logRange
+	tailLines     int64 // size of rangeTail
+	showTimes     bool  // server timestamp gutter
+
+	// Server timestamps parallel to lines, nil when unknown.
+	times []time.Time
+	// Aggregated view: per-line source tag, parallel to lines.
+	sources []string
+
+	// Filters: shown lists the buffer lines of the filtered view, nil when
+	// no filter is active. offset and matches index the view.
+	level logLevelFilter
+	grep  *grepFilter
+	field *fieldFilter
+	shown []int
+
+	// JSON lines rendering; jsonFields are the keys shown in compact mode.
+	jsonMode   jsonLogMode
+	jsonFields []string
+
+	// Search: view lines matching the pattern, matchIdx is the current one.
+	searchPattern string
+	search        *regexp.Regexp
+	matches       []int
+	matchIdx      int
+}
+
+// interleaveWindow bounds how far back a late line from another pod is
+// moved to keep the aggregated buffer ordered by timestamp.
+const interleaveWindow = 1000
+
+func (ls *logState) aggregated() bool {
+	return ls.selector != ""
 }
 
 func (ls *logState) setContent(content string) {
 	ls.content = content
 	ls.lines = strings.Split(content, "\n")
+	ls.times = nil
 	// Jump to bottom
 	ls.offset = 0
+	ls.shown = nil
+	ls.refilter(0)
+	ls.matchIdx = 0
+	ls.updateMatches(0)
 }
 
-func (ls *logState) scrollDown(amount, viewHeight int) {
-	maxOffset := len(ls.lines) - viewHeight
-	if maxOffset < 0 {
-		maxOffset = 0
+// appendLines adds streamed lines, keeping at most maxFollowLines in a
+// fixed-size window. The view sticks to the bottom unless paused.
+func (ls *logState) appendLines(lines []string, viewHeight int) {
+	ls.lines = append(ls.lines, lines...)
+	if ls.times != nil {
+		ls.times = append(ls.times, make([]time.Time, len(lines))...)
+	}
+	ls.afterAppend(viewHeight)
+}
+
+// appendLogLines adds streamed lines. In the aggregated view each line is
+// tagged with its pod/container and inserted in timestamp order.
+func (ls *logState) appendLogLines(entries []domain.LogLine, viewHeight int) {
+	if !ls.aggregated() {
+		if ls.times == nil {
+			ls.times = make([]time.Time, len(ls.lines), len(ls.lines)+len(entries))
+		}
+		for _, e := range entries {
+			ls.lines = append(ls.lines, e.Text)
+			ls.times = append(ls.times, e.Timestamp)
+		}
+		ls.afterAppend(viewHeight)
+		return
+	}
+	for _, e := range entries {
+		i := len(ls.lines)
+		for j := 0; j < interleaveWindow && i > 0 && !e.Timestamp.IsZero() && ls.times[i-1].After(e.Timestamp); j++ {
+			i--
+		}
+		ls.lines = slices.Insert(ls.lines, i, e.Text)
+		ls.sources = slices.Insert(ls.sources, i, logSource(e))
+		ls.times = slices.Insert(ls.times, i, e.Timestamp)
+	}
+	ls.afterAppend(viewHeight)
+}
+
+// afterAppend trims the buffer, then updates the filtered view, the offset
+// and the search matches.
+func (ls *logState) afterAppend(viewHeight int) {
+	shift := ls.refilter(ls.dropOldest())
+	ls.offset = max(ls.offset-shift, 0)
+	ls.updateMatches(shift)
+	if !ls.paused {
+		ls.jumpToBottom(viewHeight)
+	}
+}
+
+// dropOldest keeps at most maxFollowLines lines in a fixed-size window and
+// returns the number of lines dropped.
+func (ls *logState) dropOldest() int {
+	drop := len(ls.lines) - maxFollowLines
+	if drop <= 0 {
+		return 0
+	}
+	ls.lines = shiftOut(ls.lines, drop)
+	if ls.sources != nil {
+		ls.sources = shiftOut(ls.sources, drop)
+	}
+	if ls.times != nil {
+		ls.times = shiftOut(ls.times, drop)
 	}
+	return drop
+}
+
+func shiftOut[T any](s []T, n int) []T {
+	m := copy(s, s[n:])
+	clear(s[m:])
+	return s[:m]
+}
+
+func (ls *logState) scrollDown(amount, viewHeight int) {
+	maxOffset := ls.maxOffset(viewHeight)
 	ls.offset = min(ls.offset+amount, maxOffset)
+	if ls.offset == maxOffset {
+		ls.paused = false
+	}
 }
 
 func (ls *logState) scrollUp(amount int) {
+	if ls.offset > 0 {
+		ls.paused = ls.following
+	}
 	ls.offset = max(ls.offset-amount, 0)
 }
 
+func (ls *logState) jumpToTop() {
+	if ls.offset > 0 {
+		ls.paused = ls.following
+	}
+	ls.offset = 0
+}
+
 func (ls *logState) jumpToBottom(viewHeight int) {
-	maxOffset := len(ls.lines) - viewHeight
-	if maxOffset < 0 {
-		maxOffset = 0
+	ls.offset = ls.maxOffset(viewHeight)
+	ls.paused = false
+}
+
+// screenLines is the number of screen lines view line v takes, wrapping
+// aside: several in expanded JSON mode.
+func (ls *logState) screenLines(v int) int {
+	if ls.jsonMode != jsonExpanded {
+		return 1
+	}
+	return len(ls.displayLines(ls.lines[ls.lineAt(v)]))
+}
+
+// maxOffset is the offset that shows the last line of the view at the
+// bottom of the screen.
+func (ls *logState) maxOffset(viewHeight int) int {
+	total := ls.visibleCount()
+	if ls.jsonMode != jsonExpanded {
+		return max(total-viewHeight, 0)
+	}
+	used := 0
+	for v := total - 1; v >= 0; v-- {
+		used += ls.screenLines(v)
+		if used > viewHeight {
+			// The last line alone may not fit: show its top
+			return min(v+1, total-1)
+		}
 	}
-	ls.offset = maxOffset
+	return 0
+}
+
+// windowEnd is the view line following the last one on screen.
+func (ls *logState) windowEnd(viewHeight int) int {
+	total := ls.visibleCount()
+	if ls.jsonMode != jsonExpanded {
+		return min(ls.offset+viewHeight, total)
+	}
+	v, used := ls.offset, 0
+	for ; v < total && used < viewHeight; v++ {
+		used += ls.screenLines(v)
+	}
+	return v
 }
 
 func renderLogs(ls *logState, width, viewHeight int) string {
-	if ls.content == "" {
+	if ls.content == "" && !ls.following {
 		return "  Pas de logs disponibles\n"
 	}
 
@@ -65,17 +261,28 @@ func renderLogs(ls *logState, width, viewHeight int) string {
 	if ls.previous {
 		mode = "previous"
 	}
+	mode += ", " + ls.rng.label(ls.tailLines)
+	if ls.paused {
+		mode += ", follow en pause"
+	} else if ls.following {
+		mode += ", follow"
+	}
+	if ls.jsonMode != jsonRaw {
+		mode += ", " + ls.jsonMode.String()
+	}
 	// Visible line range
 	first := ls.offset + 1
-	last := ls.offset + viewHeight
-	if last > len(ls.lines) {
-		last = len(ls.lines)
+	last := ls.windowEnd(viewHeight)
+	total := ls.visibleCount()
+	if total == 0 {
+		first = 0
 	}
-	total := len(ls.lines)
-	position := fmt.Sprintf("[%d-%d/%d]", first, last, total)
+	position := fmt.Sprintf("[%d-%d/%d]", first, last, total) + ls.filterStatus() + ls.searchStatus()
 
 	var logHeader string
-	if ls.containerName != "" {
+	if ls.aggregated() {
+		logHeader = fmt.Sprintf("  Logs agrégés: %s (%s) %s", ls.title, mode, position)
+	} else if ls.containerName != "" {
 		logHeader = fmt.Sprintf("  Logs: %s/%s (%s) %s", ls.podName, ls.containerName, mode, position)
 	} else {
 		logHeader = fmt.Sprintf("  Logs: %s (%s) %s", ls.podName, mode, position)
@@ -83,38 +290,73 @@ func renderLogs(ls *logState, width, viewHeight int) string {
 	b.WriteString(headerStyle.Render(logHeader))
 	b.WriteString("\n")
 
+	if total == 0 && ls.filtered() {
+		b.WriteString("  Aucune ligne ne correspond au filtre\n")
+		return b.String()
+	}
+	if total == 0 {
+		b.WriteString("  En attente de logs...\n")
+		return b.String()
+	}
+
 	// Content
 	usable := width - 2 // account for "  " prefix
 	if usable < 1 {
 		usable = 1
 	}
 	rendered := 0
-	for i := ls.offset; i < len(ls.lines) && rendered < viewHeight; i++ {
-		line := ls.lines[i]
-		if ls.wrap {
-			// Wrap: split logical line into visual lines
-			for len(line) > 0 && rendered < viewHeight {
-				chunk := line
-				if len(chunk) > usable {
-					chunk = line[:usable]
-					line = line[usable:]
-				} else {
-					line = ""
+	currentMatch := ls.currentMatchLine()
+	for v := ls.offset; v < total && rendered < viewHeight; v++ {
+		i := ls.lineAt(v)
+		prefix, indent := "", ""
+		// Server timestamp gutter
+		if ls.showTimes && i < len(ls.times) {
+			prefix = renderTimeGutter(ls.times[i])
+			indent = strings.Repeat(" ", timeGutterWidth)
+		}
+		// Aggregated view: colored pod/container tag in front of the line
+		if i < len(ls.sources) {
+			prefix += renderLogSource(ls.sources[i]) + " "
+			indent += strings.Repeat(" ", len(ls.sources[i])+1)
+		}
+		lineWidth := max(usable-len(indent), 1)
+		// A JSON line may span several visual lines in expanded mode
+		for _, line := range ls.displayLines(ls.lines[i]) {
+			if rendered >= viewHeight {
+				break
+			}
+			if ls.wrap {
+				// Wrap: split logical line into visual lines. Matches are
+				// found on the whole line so that they survive the split.
+				var ranges [][]int
+				if ls.search != nil {
+					ranges = matchRanges(line, ls.search)
+				}
+				for start := 0; start < len(line) && rendered < viewHeight; start += lineWidth {
+					chunk := line[start:min(start+lineWidth, len(line))]
+					b.WriteString("  ")
+					b.WriteString(prefix)
+					if ls.search != nil {
+						b.WriteString(highlightRanges(chunk, start, ranges, v == currentMatch))
+					} else {
+						b.WriteString(colorizeLine(chunk))
+					}
+					b.WriteString("\n")
+					prefix = indent
+					rendered++
+				}
+			} else {
+				// Truncate: crop with … indicator
+				if len(line) > lineWidth {
+					line = line[:lineWidth-1] + "…"
 				}
 				b.WriteString("  ")
-				b.WriteString(colorizeLine(chunk))
+				b.WriteString(prefix)
+				b.WriteString(highlightMatches(line, ls.search, v == currentMatch))
 				b.WriteString("\n")
+				prefix = indent
 				rendered++
 			}
-		} else {
-			// Truncate: crop with … indicator
-			if len(line) > usable {
-				line = line[:usable-1] + "…"
-			}
-			b.WriteString("  ")
-			b.WriteString(colorizeLine(line))
-			b.WriteString("\n")
-			rendered++
 		}
 	}
 
@@ -132,8 +374,8 @@ func colorizeLine(line string) string {
 	})
 
 	// 2. Log levels → colored bold
-	line = reLogLevel.ReplaceAllStringFunc(line, func(m string) string {
-		switch m {
+	line = replaceLogLevels(line, func(m string) string {
+		switch strings.ToUpper(m) {
 		case "INFO":
 			return lipgloss.NewStyle().Foreground(colorPrimary).Bold(true).Render(m)
 		case "WARN", "WARNING":
@@ -181,13 +423,23 @@ func colorizeLine(line string) string {
 	return line
 }
 
-func logHelpKeys(previous, wrap bool) string {
-	wrapLabel := "w:wrap"
+func logHelpKeys(previous, wrap, following bool) string {
+	parts := []string{hint("scroll", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("page", keys.PageUp, keys.PageDown),
+		hint("chercher", keys.Filter), hint("occurrence", keys.NextHit, keys.PrevHit), hint("niveau", keys.Level),
+		hint("grep", keys.Grep), hint("json", keys.JSON), hint("champ", keys.FieldFlt), hint("période", keys.Range),
+		hint("horodatage", keys.Times), hint("exporter", keys.Export)}
+	wrapLabel := "wrap"
 	if wrap {
-		wrapLabel = "w:nowrap"
+		wrapLabel = "nowrap"
 	}
+	parts = append(parts, hint(wrapLabel, keys.Wrap))
 	if previous {
-		return fmt.Sprintf("j/k:scroll  g/G:début/fin  pgup/pgdn:page  %s  p:logs courants  esc:retour", wrapLabel)
+		return hints(append(parts, hint("logs courants", keys.Previous), hint("retour", keys.Escape))...)
+	}
+	followLabel := "follow"
+	if following {
+		followLabel = "stop follow"
 	}
-	return fmt.Sprintf("j/k:scroll  g/G:début/fin  pgup/pgdn:page  %s  p:logs précédents  esc:retour", wrapLabel)
+	return hints(append(parts, hint(followLabel, keys.Follow), hint("logs précédents", keys.Previous),
+		hint("retour", keys.Escape))...)
 }
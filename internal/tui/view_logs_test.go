@@ -221,11 +221,11 @@ func TestRenderLogs_WrapRespectsViewHeight(t *testing.T) {
 }
 
 func TestLogHelpKeys_WrapLabel(t *testing.T) {
-	help := logHelpKeys(false, false)
+	help := logHelpKeys(false, false, false)
 	if !strings.Contains(help, "w:wrap") {
 		t.Errorf("should show w:wrap, got %q", help)
 	}
-	help = logHelpKeys(false, true)
+	help = logHelpKeys(false, true, false)
 	if !strings.Contains(help, "w:nowrap") {
 		t.Errorf("should show w:nowrap when wrap is on, got %q", help)
 	}
@@ -296,6 +296,17 @@ func TestColorizeLine_LogLevel_ERROR(t *testing.T) {
 	}
 }
 
+func TestColorizeLine_LogLevel_Structured(t *testing.T) {
+	result := colorizeLine("time=12:00 level=error msg=boom")
+	red := lipgloss.NewStyle().Foreground(colorError).Bold(true).Render("error")
+	if !strings.Contains(result, "level="+red) {
+		t.Errorf("level=error should be red bold, got %q", result)
+	}
+	if result := colorizeLine("connection error"); result != "connection error" {
+		t.Errorf("free-text error should stay plain, got %q", result)
+	}
+}
+
 func TestColorizeLine_LogLevel_WARN(t *testing.T) {
 	result := colorizeLine("WARN slow query")
 	yellow := lipgloss.NewStyle().Foreground(colorWarning).Bold(true).Render("WARN")
@@ -358,3 +369,158 @@ func TestColorizeLine_FullLine(t *testing.T) {
 		t.Error("full line: 200 not colorized")
 	}
 }
+
+// --- follow mode ---
+
+func TestLogState_AppendLines_SticksToBottom(t *testing.T) {
+	ls := logState{following: true}
+	for i := 0; i < 30; i++ {
+		ls.appendLines([]string{"line"}, 10)
+	}
+	if ls.offset != 20 {
+		t.Errorf("offset = %d, want 20 (bottom)", ls.offset)
+	}
+}
+
+func TestLogState_AppendLines_PausedWhenScrolledUp(t *testing.T) {
+	ls := logState{following: true}
+	ls.appendLines(make([]string, 30), 10)
+	ls.scrollUp(5)
+	if !ls.paused {
+		t.Fatal("scrolling up while following should pause")
+	}
+
+	ls.appendLines(make([]string, 10), 10)
+	if ls.offset != 15 {
+		t.Errorf("offset = %d, want 15 (unchanged while paused)", ls.offset)
+	}
+
+	ls.scrollDown(100, 10)
+	if ls.paused {
+		t.Error("reaching the bottom should resume auto-scroll")
+	}
+}
+
+func TestLogState_AppendLines_CapsBuffer(t *testing.T) {
+	ls := logState{following: true, paused: true}
+	ls.appendLines(make([]string, maxFollowLines), 10)
+	ls.offset = 100
+	ls.appendLines([]string{"a", "b", "c"}, 10)
+
+	if len(ls.lines) != maxFollowLines {
+		t.Errorf("lines = %d, want %d", len(ls.lines), maxFollowLines)
+	}
+	if ls.lines[len(ls.lines)-1] != "c" {
+		t.Errorf("last line = %q, want c", ls.lines[len(ls.lines)-1])
+	}
+	if ls.offset != 97 {
+		t.Errorf("offset = %d, want 97 (shifted with dropped lines)", ls.offset)
+	}
+}
+
+func TestRenderLogs_FollowWaiting(t *testing.T) {
+	ls := logState{podName: "web-1", following: true}
+	output := renderLogs(&ls, 80, 10)
+	if !strings.Contains(output, "follow") || !strings.Contains(output, "En attente de logs") {
+		t.Errorf("output = %q, want follow header and waiting message", output)
+	}
+}
+
+func TestLogHelpKeys_FollowLabel(t *testing.T) {
+	if help := logHelpKeys(false, false, true); !strings.Contains(help, "f:stop follow") {
+		t.Errorf("help = %q, want f:stop follow", help)
+	}
+}
+
+func newFollowTestModel() (Model, *domain.MockGateway) {
+	mock := &domain.MockGateway{
+		NamespaceVal: "default",
+		LogStreamCh:  make(chan domain.LogLine, 10),
+	}
+	m := NewModel(mock, nil, nil)
+	m.view = ViewLogs
+	m.prevView = ViewPods
+	m.width = 120
+	m.height = 30
+	m.logState = logState{podName: "web-1", containerName: "app"}
+	m.logState.setContent("old snapshot")
+	return m, mock
+}
+
+func TestFollowKey_StreamsLines(t *testing.T) {
+	m, mock := newFollowTestModel()
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = updated.(Model)
+	if !m.logState.following || cmd == nil {
+		t.Fatal("f should start following")
+	}
+	if mock.LoggedContainer != "app" {
+		t.Errorf("LoggedContainer = %q, want app", mock.LoggedContainer)
+	}
+
+	mock.LogStreamCh <- domain.LogLine{Text: "one"}
+	mock.LogStreamCh <- domain.LogLine{Text: "two"}
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+
+	if strings.Join(m.logState.lines, ",") != "one,two" {
+		t.Errorf("lines = %q, want snapshot replaced by streamed lines", m.logState.lines)
+	}
+	if cmd == nil {
+		t.Error("should keep listening")
+	}
+
+	close(mock.LogStreamCh)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.logState.following {
+		t.Error("follow should stop when the stream ends")
+	}
+	if m.logState.content != "one\ntwo" {
+		t.Errorf("content = %q, want streamed lines kept", m.logState.content)
+	}
+}
+
+func TestFollowKey_TogglesOffAndIgnoresStaleLines(t *testing.T) {
+	m, mock := newFollowTestModel()
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = updated.(Model)
+	if m.logState.following || m.logCancel != nil {
+		t.Fatal("second f should stop following")
+	}
+
+	updated, cmd := m.Update(logLinesMsg{ch: mock.LogStreamCh, lines: []domain.LogLine{{Text: "late"}}})
+	if cmd != nil || len(updated.(Model).logState.lines) != 0 {
+		t.Error("lines from a stopped stream should be ignored")
+	}
+}
+
+func TestFollow_EscapeCancelsStream(t *testing.T) {
+	m, _ := newFollowTestModel()
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = updated.(Model)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.logCh != nil || m.logCancel != nil {
+		t.Error("leaving the log view should cancel the stream")
+	}
+}
+
+func TestFollow_StreamError_ShowsToast(t *testing.T) {
+	m, mock := newFollowTestModel()
+	mock.StreamPodLogsErr = &domain.APIError{Type: domain.ErrForbidden, Message: "interdit"}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = updated.(Model)
+	if m.logState.following {
+		t.Error("follow should not start on error")
+	}
+	if !strings.Contains(m.toast.message, "interdit") {
+		t.Errorf("toast = %q, want error", m.toast.message)
+	}
+}
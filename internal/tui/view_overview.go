@@ -0,0 +1,358 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+// overviewWorkers bounds the namespaces listed at the same time.
+const overviewWorkers = 4
+
+// overviewWarningWindow is how far back Warning events count as recent, and
+// maxOverviewWarnings how many of them are listed.
+const (
+	overviewWarningWindow = time.Hour
+	maxOverviewWarnings   = 20
+)
+
+// podPhases is the display order of the phase counts.
+var podPhases = []string{"Running", "Pending", "Succeeded", "Failed", "Unknown"}
+
+// overviewData is the cluster health summary, gathered in one list across
+// all namespaces, or namespace by namespace when the user may only read
+// their projects.
+type overviewData struct {
+	namespaces   int
+	inaccessible int // namespaces whose resources could not be listed
+	phases       map[string]int
+	failing      []domain.PodInfo
+	degraded     []domain.DeploymentInfo
+	warnings     []domain.EventInfo
+	nodes        []domain.NodeInfo
+	nodesErr     error
+}
+
+type overviewLoadedMsg struct{ data *overviewData }
+
+// overviewTarget is where a row of the overview leads: a list view of one
+// namespace (all of them when namespace is empty), filtered.
+type overviewTarget struct {
+	view      View
+	namespace string
+	filter    string
+}
+
+// overviewRow is a line of the overview: a section header when target is
+// nil, a row leading to a filtered view otherwise.
+type overviewRow struct {
+	text   string
+	target *overviewTarget
+}
+
+// loadOverview lists pods, deployments and events of every accessible
+// namespace, and the nodes when the user may read them.
+func loadOverview(client domain.KubeGateway) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		namespaces, err := client.ListNamespaces(ctx)
+		if err != nil {
+			return apiErrMsg{err}
+		}
+
+		data := &overviewData{namespaces: len(namespaces), phases: make(map[string]int)}
+		since := time.Now().Add(-overviewWarningWindow)
+		listed, err := data.addAllNamespaces(ctx, client, since)
+		if err != nil {
+			return apiErrMsg{err}
+		}
+		if !listed {
+			data.addEachNamespace(ctx, client, namespaces, since)
+		}
+		data.nodes, data.nodesErr = client.ListNodes(ctx)
+		data.sort()
+		return overviewLoadedMsg{data}
+	}
+}
+
+// addAllNamespaces counts the resources of every namespace with one list
+// each. It reports false when the user may not list across namespaces.
+func (d *overviewData) addAllNamespaces(ctx context.Context, client domain.KubeGateway, since time.Time) (bool, error) {
+	// A scoped copy: the shared gateway must not change mode in the
+	// background
+	all := client.InNamespace(client.GetNamespace())
+	all.SetAllNamespaces(true)
+	var deps []domain.DeploymentInfo
+	var events []domain.EventInfo
+	pods, err := all.ListPods(ctx)
+	if err == nil {
+		deps, err = all.ListDeployments(ctx)
+	}
+	if err == nil {
+		events, err = all.ListEvents(ctx)
+	}
+	if err != nil {
+		var apiErr *domain.APIError
+		if errors.As(err, &apiErr) && apiErr.Type == domain.ErrForbidden {
+			return false, nil
+		}
+		return false, err
+	}
+	d.add(pods, deps, events, since)
+	return true, nil
+}
+
+// addEachNamespace counts the resources namespace by namespace, a few at a
+// time, so that only access to the user's projects is needed.
+func (d *overviewData) addEachNamespace(ctx context.Context, client domain.KubeGateway, namespaces []domain.NamespaceInfo, since time.Time) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, overviewWorkers)
+	for _, ns := range namespaces {
+		// Gateways are created here: InNamespace is not safe for
+		// concurrent use
+		nsClient := client.InNamespace(ns.Name)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			pods, podErr := nsClient.ListPods(ctx)
+			deps, depErr := nsClient.ListDeployments(ctx)
+			events, evtErr := nsClient.ListEvents(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if podErr != nil || depErr != nil || evtErr != nil {
+				d.inaccessible++
+			}
+			d.add(pods, deps, events, since)
+		}()
+	}
+	wg.Wait()
+}
+
+// add counts the resources of one namespace, or of all of them.
+func (d *overviewData) add(pods []domain.PodInfo, deps []domain.DeploymentInfo, events []domain.EventInfo, since time.Time) {
+	for _, p := range pods {
+		phase := p.Phase
+		if phase == "" {
+			phase = "Unknown"
+		}
+		d.phases[phase]++
+		if isFailingPod(p) {
+			d.failing = append(d.failing, p)
+		}
+	}
+	for _, dep := range deps {
+		if dep.Available < dep.Replicas {
+			d.degraded = append(d.degraded, dep)
+		}
+	}
+	for _, e := range events {
+		if e.Type == "Warning" && e.CreatedAt.After(since) {
+			d.warnings = append(d.warnings, e)
+		}
+	}
+}
+
+// sort orders the lists for display: namespace then name, latest warnings
+// first.
+func (d *overviewData) sort() {
+	sort.Slice(d.failing, func(i, j int) bool {
+		if d.failing[i].Namespace != d.failing[j].Namespace {
+			return d.failing[i].Namespace < d.failing[j].Namespace
+		}
+		return d.failing[i].Name < d.failing[j].Name
+	})
+	sort.Slice(d.degraded, func(i, j int) bool {
+		if d.degraded[i].Namespace != d.degraded[j].Namespace {
+			return d.degraded[i].Namespace < d.degraded[j].Namespace
+		}
+		return d.degraded[i].Name < d.degraded[j].Name
+	})
+	sort.Slice(d.warnings, func(i, j int) bool {
+		return d.warnings[i].CreatedAt.After(d.warnings[j].CreatedAt)
+	})
+	if len(d.warnings) > maxOverviewWarnings {
+		d.warnings = d.warnings[:maxOverviewWarnings]
+	}
+}
+
+// isFailingPod reports pods in a failed phase or with a container stuck in
+// an error state.
+func isFailingPod(p domain.PodInfo) bool {
+	if p.Phase == "Failed" {
+		return true
+	}
+	switch strings.TrimPrefix(p.Status, "Init:") {
+	case "Failed", "Error", "CrashLoopBackOff", "ImagePullBackOff", "ErrImagePull",
+		"OOMKilled", "Evicted", "CreateContainerConfigError", "CreateContainerError",
+		"InvalidImageName", "RunContainerError":
+		return true
+	}
+	return false
+}
+
+// rows lays the summary out as sections of jumpable rows.
+func (d *overviewData) rows() []overviewRow {
+	var rows []overviewRow
+	header := fmt.Sprintf("PODS — %d namespaces", d.namespaces)
+	if d.inaccessible > 0 {
+		header += fmt.Sprintf(" (%d inaccessibles)", d.inaccessible)
+	}
+	rows = append(rows, overviewRow{text: header})
+	for _, phase := range podPhases {
+		rows = append(rows, overviewRow{
+			text:   fmt.Sprintf("%-12s %d", phase, d.phases[phase]),
+			target: &overviewTarget{view: ViewPods, filter: phase},
+		})
+	}
+
+	rows = append(rows, overviewRow{text: fmt.Sprintf("PODS EN ÉCHEC — %d", len(d.failing))})
+	for _, p := range d.failing {
+		rows = append(rows, overviewRow{
+			text:   fmt.Sprintf("%-20s %-42s %-22s %d restarts", truncate(p.Namespace, 19), truncate(p.Name, 41), p.Status, p.Restarts),
+			target: &overviewTarget{view: ViewPods, namespace: p.Namespace, filter: p.Name},
+		})
+	}
+
+	rows = append(rows, overviewRow{text: fmt.Sprintf("DEPLOYMENTS DÉGRADÉS — %d", len(d.degraded))})
+	for _, dep := range d.degraded {
+		rows = append(rows, overviewRow{
+			text: fmt.Sprintf("%-20s %-42s %d/%d disponibles", truncate(dep.Namespace, 19), truncate(dep.Name, 41),
+				dep.Available, dep.Replicas),
+			target: &overviewTarget{view: ViewDeployments, namespace: dep.Namespace, filter: dep.Name},
+		})
+	}
+
+	rows = append(rows, overviewRow{text: fmt.Sprintf("WARNINGS DE LA DERNIÈRE HEURE — %d", len(d.warnings))})
+	for _, e := range d.warnings {
+		rows = append(rows, overviewRow{
+			text: fmt.Sprintf("%-20s %-22s %-30s %s", truncate(e.Namespace, 19), truncate(e.Reason, 21),
+				truncate(e.Object, 29), truncate(e.Message, 60)),
+			target: &overviewTarget{view: ViewEvents, namespace: e.Namespace, filter: e.Reason},
+		})
+	}
+
+	if d.nodesErr != nil {
+		rows = append(rows, overviewRow{text: "NŒUDS — non disponibles (" + nodesErrLabel(d.nodesErr) + ")"})
+		return rows
+	}
+	ready := 0
+	for _, n := range d.nodes {
+		if n.Ready {
+			ready++
+		}
+	}
+	rows = append(rows, overviewRow{text: fmt.Sprintf("NŒUDS — %d/%d prêts", ready, len(d.nodes))})
+	for _, n := range d.nodes {
+		if n.Ready && !strings.Contains(n.Status, "SchedulingDisabled") {
+			continue
+		}
+		rows = append(rows, overviewRow{
+			text:   fmt.Sprintf("%-30s %-28s %-16s %s", truncate(n.Name, 29), n.Status, truncate(n.Roles, 15), truncate(n.Reason, 60)),
+			target: &overviewTarget{view: ViewPods, filter: n.Name},
+		})
+	}
+	return rows
+}
+
+func nodesErrLabel(err error) string {
+	var apiErr *domain.APIError
+	if errors.As(err, &apiErr) && apiErr.Type == domain.ErrForbidden {
+		return "accès refusé"
+	}
+	return err.Error()
+}
+
+// overviewRows is the overview with the rows matching the filter; section
+// headers are kept.
+func (m Model) overviewRows() []overviewRow {
+	if m.overview == nil {
+		return nil
+	}
+	rows := m.overview.rows()
+	f := m.filterText()
+	if f == "" {
+		return rows
+	}
+	var result []overviewRow
+	for _, r := range rows {
+		if r.target == nil || strings.Contains(strings.ToLower(r.text), f) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func renderOverview(rows []overviewRow, cursor, width, maxVisible int) string {
+	if len(rows) == 0 {
+		return "  Chargement du résumé...\n"
+	}
+
+	var b strings.Builder
+	start := 0
+	if cursor >= maxVisible {
+		start = cursor - maxVisible + 1
+	}
+	for i := start; i < len(rows) && i < start+maxVisible; i++ {
+		r := rows[i]
+		line := "    " + r.text
+		if r.target == nil {
+			line = "  " + headerStyle.Render(r.text)
+		}
+		if i == cursor {
+			b.WriteString(selectedStyle.Width(width).Render(line))
+		} else {
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func overviewHelpKeys() string {
+	return hints(hint("nav", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("ouvrir", keys.Enter), hint("filtre", keys.Filter),
+		hint("refresh", keys.Refresh), hint("quit", keys.Quit))
+}
+
+// openOverviewRow jumps to the filtered view of the selected row. Rows of a
+// namespace switch to it; the others list every namespace.
+func (m Model) openOverviewRow() (tea.Model, tea.Cmd) {
+	rows := m.overviewRows()
+	if m.cursor >= len(rows) || rows[m.cursor].target == nil {
+		return m, nil
+	}
+	t := *rows[m.cursor].target
+
+	restartAlerts := false
+	if t.namespace == "" {
+		restartAlerts = !m.client.AllNamespaces()
+		m.client.SetAllNamespaces(true)
+	} else {
+		restartAlerts = m.client.AllNamespaces() || m.client.GetNamespace() != t.namespace
+		m.client.SetAllNamespaces(false)
+		m.client.SetNamespace(t.namespace)
+	}
+	m.targetNS = ""
+	m.pods, m.deployments, m.events = nil, nil, nil
+
+	updated, cmd := m.switchView(t.view)
+	m = updated.(Model)
+	m.filter.SetValue(t.filter)
+	if !restartAlerts {
+		return m, cmd
+	}
+	m.alerts.stop()
+	m.alerts.unseen = 0
+	return m, tea.Batch(cmd, m.startAlerts())
+}
@@ -0,0 +1,174 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Taishi66/okd-tui/internal/domain"
+)
+
+func newOverviewMock() *domain.MockGateway {
+	now := time.Now()
+	return &domain.MockGateway{
+		NamespaceVal: "default",
+		Namespaces:   []domain.NamespaceInfo{{Name: "team-a"}},
+		Pods: []domain.PodInfo{
+			{Name: "web-1", Namespace: "team-a", Phase: "Running", Status: "Running"},
+			{Name: "web-2", Namespace: "team-a", Phase: "Running", Status: "CrashLoopBackOff", Restarts: 7},
+			{Name: "job-1", Namespace: "team-a", Phase: "Succeeded", Status: "Completed"},
+			{Name: "old-1", Namespace: "team-a", Phase: "Failed", Status: "Evicted"},
+		},
+		Deployments: []domain.DeploymentInfo{
+			{Name: "web", Namespace: "team-a", Replicas: 3, Available: 1},
+			{Name: "api", Namespace: "team-a", Replicas: 2, Available: 2},
+		},
+		Events: []domain.EventInfo{
+			{Type: "Warning", Reason: "BackOff", Object: "Pod/web-2", Namespace: "team-a", CreatedAt: now.Add(-time.Minute)},
+			{Type: "Warning", Reason: "FailedMount", Object: "Pod/web-1", Namespace: "team-a", CreatedAt: now.Add(-3 * time.Hour)},
+			{Type: "Normal", Reason: "Pulled", Object: "Pod/web-1", Namespace: "team-a", CreatedAt: now},
+		},
+		Nodes: []domain.NodeInfo{
+			{Name: "worker-1", Ready: true, Status: "Ready"},
+			{Name: "worker-2", Status: "NotReady", Reason: "Kubelet stopped posting node status."},
+		},
+	}
+}
+
+func TestLoadOverview(t *testing.T) {
+	mock := newOverviewMock()
+	msg := loadOverview(mock)()
+	loaded, ok := msg.(overviewLoadedMsg)
+	if !ok {
+		t.Fatalf("msg = %T, want overviewLoadedMsg", msg)
+	}
+	d := loaded.data
+
+	if d.phases["Running"] != 2 || d.phases["Succeeded"] != 1 || d.phases["Failed"] != 1 {
+		t.Errorf("phases = %v", d.phases)
+	}
+	if len(d.failing) != 2 || d.failing[0].Name != "old-1" || d.failing[1].Name != "web-2" {
+		t.Errorf("failing = %+v, want old-1 and web-2", d.failing)
+	}
+	if len(d.degraded) != 1 || d.degraded[0].Name != "web" {
+		t.Errorf("degraded = %+v, want web", d.degraded)
+	}
+	if len(d.warnings) != 1 || d.warnings[0].Reason != "BackOff" {
+		t.Errorf("warnings = %+v, want the recent BackOff only", d.warnings)
+	}
+	// One list across all namespaces, no fan-out
+	if mock.ListPodsCalls != 1 || mock.ListDeploymentsCalls != 1 || mock.ListEventsCalls != 1 {
+		t.Errorf("lists = %d pods, %d deployments, %d events, want one of each",
+			mock.ListPodsCalls, mock.ListDeploymentsCalls, mock.ListEventsCalls)
+	}
+
+	var texts []string
+	for _, r := range d.rows() {
+		texts = append(texts, r.text)
+	}
+	out := strings.Join(texts, "\n")
+	for _, want := range []string{"NŒUDS — 1/2 prêts", "worker-2", "DEPLOYMENTS DÉGRADÉS — 1", "1/3 disponibles"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("overview should contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "worker-1") {
+		t.Error("ready nodes should only be counted")
+	}
+}
+
+// projectsOnlyGateway forbids the lists across all namespaces, like a user
+// who may only read their projects. InNamespace leaves that mode, like the
+// k8s client does.
+type projectsOnlyGateway struct{ *domain.MockGateway }
+
+func (g projectsOnlyGateway) InNamespace(ns string) domain.KubeGateway {
+	g.MockGateway.InNamespace(ns)
+	g.SetAllNamespaces(false)
+	return g
+}
+
+func (g projectsOnlyGateway) ListPods(ctx context.Context) ([]domain.PodInfo, error) {
+	if g.AllNamespaces() {
+		return nil, &domain.APIError{Type: domain.ErrForbidden, Message: "Accès refusé"}
+	}
+	return g.MockGateway.ListPods(ctx)
+}
+
+func TestLoadOverview_ForbiddenFallsBackToEachNamespace(t *testing.T) {
+	mock := newOverviewMock()
+	msg := loadOverview(projectsOnlyGateway{mock})()
+	loaded, ok := msg.(overviewLoadedMsg)
+	if !ok {
+		t.Fatalf("msg = %T, want overviewLoadedMsg", msg)
+	}
+	d := loaded.data
+	if d.phases["Running"] != 2 || len(d.degraded) != 1 || d.inaccessible != 0 {
+		t.Errorf("phases = %v, degraded = %d, inaccessible = %d, want team-a counted",
+			d.phases, len(d.degraded), d.inaccessible)
+	}
+	routed := strings.Join(mock.RoutedNamespaces, ",")
+	if routed != "default,team-a" {
+		t.Errorf("routed namespaces = %v, want the all-namespaces try then team-a", routed)
+	}
+}
+
+func TestLoadOverview_NodesForbidden(t *testing.T) {
+	mock := newOverviewMock()
+	mock.ListNodesErr = &domain.APIError{Type: domain.ErrForbidden, Message: "Accès refusé"}
+	d := loadOverview(mock)().(overviewLoadedMsg).data
+
+	rows := d.rows()
+	last := rows[len(rows)-1]
+	if last.target != nil || !strings.Contains(last.text, "accès refusé") {
+		t.Errorf("last row = %+v, want a node section saying access is denied", last)
+	}
+}
+
+func TestOverview_IsStartView(t *testing.T) {
+	m := NewModel(newOverviewMock(), nil, nil)
+	if m.view != ViewOverview {
+		t.Errorf("start view = %v, want ViewOverview", m.view)
+	}
+}
+
+func TestOverview_EnterJumpsToFilteredView(t *testing.T) {
+	mock := newOverviewMock()
+	m := NewModel(mock, nil, nil)
+	m.width = 120
+	m.height = 40
+	updated, _ := m.Update(loadOverview(mock)())
+	m = updated.(Model)
+
+	// A failing pod opens the pods of its namespace
+	rows := m.overviewRows()
+	for i, r := range rows {
+		if r.target != nil && r.target.filter == "web-2" {
+			m.cursor = i
+		}
+	}
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.view != ViewPods || mock.NamespaceVal != "team-a" || mock.AllNamespacesVal {
+		t.Errorf("view = %v, ns = %q, all = %v, want pods of team-a", m.view, mock.NamespaceVal, mock.AllNamespacesVal)
+	}
+	if m.filter.Value() != "web-2" || cmd == nil {
+		t.Errorf("filter = %q, want web-2 and a load cmd", m.filter.Value())
+	}
+
+	// A phase count opens the pods of every namespace
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}})
+	m = updated.(Model)
+	updated, _ = m.Update(loadOverview(mock)())
+	m = updated.(Model)
+	m.cursor = 1 // Running
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.view != ViewPods || !mock.AllNamespacesVal || m.filter.Value() != "Running" {
+		t.Errorf("view = %v, all = %v, filter = %q, want Running pods of every namespace",
+			m.view, mock.AllNamespacesVal, m.filter.Value())
+	}
+}
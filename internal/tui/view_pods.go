@@ -1,29 +1,38 @@
 package tui
 
 import (
-	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/Taishi66/okd-tui/internal/config"
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
-func renderPodList(pods []domain.PodInfo, cursor, width, maxVisible int) string {
+func podColumns(allNS bool, extra []config.ColumnConfig) []column {
+	return append([]column{
+		namespaceColumn(allNS),
+		{title: "NAME", width: 24, maxWidth: 56, flex: true},
+		{title: "STATUS", width: 18, priority: 1, render: colorizeStatus},
+		{title: "READY", width: 7, from: widthMain, priority: 3},
+		{title: "RESTARTS", width: 8, from: widthStandard, priority: 5},
+		{title: "AGE", width: 6, from: widthMain, priority: 4},
+		{title: "NODE", width: 16, maxWidth: 32, from: widthExtended, priority: 7, flex: true},
+		{title: "IMAGE", width: 24, from: widthExtended, priority: 8, flex: true},
+	}, extraColumns(extra)...)
+}
+
+func renderPodList(pods []domain.PodInfo, cursor, width, maxVisible int, showNS bool, extra []config.ColumnConfig,
+	marks map[string]string) string {
 	if len(pods) == 0 {
-		return "  Aucun pod dans ce namespace\n"
+		return "  Aucun pod " + listScope(showNS) + "\n"
 	}
 
 	var b strings.Builder
 
-	// Responsive columns
-	if width >= 100 {
-		header := fmt.Sprintf("  %-42s %-18s %-7s %-10s %s", "NAME", "STATUS", "READY", "RESTARTS", "AGE")
-		b.WriteString(headerStyle.Render(header))
-		b.WriteString("\n")
-	} else {
-		header := fmt.Sprintf("  %-35s %-18s %s", "NAME", "STATUS", "READY")
-		b.WriteString(headerStyle.Render(header))
-		b.WriteString("\n")
-	}
+	cols := podColumns(showNS, extra)
+	widths := layoutColumns(cols, width, 2)
+	b.WriteString(renderColumnHeader(cols, widths, "  "))
+	b.WriteString("\n")
 
 	start := 0
 	if cursor >= maxVisible {
@@ -32,20 +41,9 @@ func renderPodList(pods []domain.PodInfo, cursor, width, maxVisible int) string
 
 	for i := start; i < len(pods) && i < start+maxVisible; i++ {
 		p := pods[i]
-		var line string
-		if width >= 100 {
-			line = fmt.Sprintf("  %-42s %-18s %-7s %-10d %s",
-				truncate(p.Name, 41),
-				colorizeStatus(p.Status),
-				p.Ready,
-				p.Restarts,
-				p.Age)
-		} else {
-			line = fmt.Sprintf("  %-35s %-18s %s",
-				truncate(p.Name, 34),
-				colorizeStatus(p.Status),
-				p.Ready)
-		}
+		line := renderColumnRow(cols, widths, rowPrefix(marks, p.Namespace, p.Name), append([]string{
+			p.Namespace, p.Name, p.Status, p.Ready, strconv.Itoa(int(p.Restarts)), p.Age, p.Node, podImages(p),
+		}, extraCells(extra, p.Extra)...))
 
 		if i == cursor {
 			b.WriteString(selectedStyle.Width(width).Render(line))
@@ -58,6 +56,21 @@ func renderPodList(pods []domain.PodInfo, cursor, width, maxVisible int) string
 	return b.String()
 }
 
+// podImages lists the images of the app containers.
+func podImages(p domain.PodInfo) string {
+	var images []string
+	for _, c := range p.Containers {
+		if c.Kind == "" || c.Kind == domain.ContainerKindApp {
+			images = append(images, c.Image)
+		}
+	}
+	return strings.Join(images, ",")
+}
+
 func podHelpKeys() string {
-	return "j/k:nav  g/G:début/fin  enter:logs  s:shell  d:suppr  y:yaml  t:tri  /:filtre  r:refresh  q:quit"
+	return hints(hint("nav", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("logs", keys.Enter),
+		hint("logs label", keys.LabelLog), hint("shell", keys.Shell), hint("cmd", keys.RunCmd), hint("debug", keys.Debug),
+		hint("copie", keys.Download, keys.Upload), hint("suppr", keys.Delete), hint("marquer", keys.Mark, keys.MarkAll), hint("yaml", keys.YAML),
+		hint("events", keys.ObjEvts), hint("tous ns", keys.AllNS), hint("tri", keys.Sort), hint("filtre", keys.Filter),
+		hint("refresh", keys.Refresh), hint("quit", keys.Quit))
 }
@@ -1,12 +1,17 @@
 package tui
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/Taishi66/okd-tui/internal/domain"
 )
 
+var projectColumns = []column{
+	{title: "NAME", width: 24, maxWidth: 56, flex: true},
+	{title: "STATUS", width: 12, priority: 1, render: colorizeStatus},
+	{title: "AGE", width: 6, from: widthMain, priority: 2},
+}
+
 func renderProjectList(namespaces []domain.NamespaceInfo, cursor, width, maxVisible int, activeNS string) string {
 	if len(namespaces) == 0 {
 		return "  Aucun projet accessible\n"
@@ -14,8 +19,8 @@ func renderProjectList(namespaces []domain.NamespaceInfo, cursor, width, maxVisi
 
 	var b strings.Builder
 
-	header := fmt.Sprintf("  %-40s %-12s %s", "NAME", "STATUS", "AGE")
-	b.WriteString(headerStyle.Render(header))
+	widths := layoutColumns(projectColumns, width, 2)
+	b.WriteString(renderColumnHeader(projectColumns, widths, "  "))
 	b.WriteString("\n")
 
 	start := 0
@@ -29,8 +34,7 @@ func renderProjectList(namespaces []domain.NamespaceInfo, cursor, width, maxVisi
 		if ns.Name == activeNS {
 			marker = "> "
 		}
-		line := fmt.Sprintf("%s%-40s %-12s %s",
-			marker, truncate(ns.Name, 39), colorizeStatus(ns.Status), ns.Age)
+		line := renderColumnRow(projectColumns, widths, marker, []string{ns.Name, ns.Status, ns.Age})
 
 		if i == cursor {
 			b.WriteString(selectedStyle.Width(width).Render(line))
@@ -44,5 +48,6 @@ func renderProjectList(namespaces []domain.NamespaceInfo, cursor, width, maxVisi
 }
 
 func projectHelpKeys() string {
-	return "j/k:nav  g/G:début/fin  enter:sélectionner  /:filtre  r:refresh  q:quit"
+	return hints(hint("nav", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("sélectionner", keys.Enter), hint("filtre", keys.Filter),
+		hint("refresh", keys.Refresh), hint("quit", keys.Quit))
 }
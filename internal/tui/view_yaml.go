@@ -72,5 +72,6 @@ func renderYAMLView(ys *yamlViewState, width, viewHeight int) string {
 }
 
 func yamlHelpKeys() string {
-	return "j/k:scroll  g/G:début/fin  pgup/pgdn:page  esc:retour"
+	return hints(hint("scroll", keys.Down, keys.Up), hint("début/fin", keys.Top, keys.Bottom), hint("page", keys.PageUp, keys.PageDown), hint("exporter", keys.Export),
+		hint("retour", keys.Escape))
 }